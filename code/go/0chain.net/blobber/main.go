@@ -17,9 +17,12 @@ import (
 	"0chain.net/blobbercore/challenge"
 	"0chain.net/blobbercore/config"
 	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/errors"
 	"0chain.net/blobbercore/filestore"
 	"0chain.net/blobbercore/handler"
 	"0chain.net/blobbercore/readmarker"
+	"0chain.net/blobbercore/scrubber"
+	"0chain.net/blobbercore/webhook"
 	"0chain.net/blobbercore/writemarker"
 	"0chain.net/core/build"
 	"0chain.net/core/chain"
@@ -66,6 +69,9 @@ func SetupWorkerConfig() {
 	config.Configuration.ChallengeResolveFreq = viper.GetInt64("challenge_response.frequency")
 	config.Configuration.ChallengeResolveNumWorkers = viper.GetInt("challenge_response.num_workers")
 	config.Configuration.ChallengeMaxRetires = viper.GetInt("challenge_response.max_retries")
+	config.Configuration.ChallengeRetryBackoff = viper.GetDuration("challenge_response.retry_backoff")
+	config.Configuration.ChallengeMaxRetryBackoff = viper.GetDuration("challenge_response.max_retry_backoff")
+	config.Configuration.ChallengeValidatorTimeout = viper.GetDuration("challenge_response.validator_timeout")
 
 	config.Configuration.ColdStorageMinimumFileSize = viper.GetInt64("cold_storage.min_file_size")
 	config.Configuration.ColdStorageTimeLimitInHours = viper.GetInt64("cold_storage.file_time_limit_in_hours")
@@ -74,13 +80,41 @@ func SetupWorkerConfig() {
 	config.Configuration.ColdStorageDeleteLocalCopy = viper.GetBool("cold_storage.delete_local_copy")
 	config.Configuration.ColdStorageDeleteCloudCopy = viper.GetBool("cold_storage.delete_cloud_copy")
 
+	config.Configuration.FileStoreBackend = viper.GetString("filestore.backend")
+	config.Configuration.FileStoreDedup = viper.GetBool("filestore.dedup")
+	config.Configuration.FileStoreCompression = viper.GetBool("filestore.compression.enabled")
+	config.Configuration.FileStoreCompressMimeTypes = viper.GetStringSlice("filestore.compression.mime_types")
+
 	config.Configuration.MinioStart = viper.GetBool("minio.start")
 	config.Configuration.MinioWorkerFreq = viper.GetInt64("minio.worker_frequency")
 	config.Configuration.MinioUseSSL = viper.GetBool("minio.use_ssl")
 
 	config.Configuration.Capacity = viper.GetInt64("capacity")
 	config.Configuration.MaxFileSize = viper.GetInt64("max_file_size")
-
+	config.Configuration.MaxThumbnailSize = viper.GetInt64("max_thumbnail_size")
+	config.Configuration.MaxBatchUploadFiles = viper.GetInt("max_batch_upload_files")
+	config.Configuration.ReferenceSnapshots = viper.GetInt("reference_snapshots")
+	config.Configuration.MaxFileVersions = viper.GetInt("file_versions.max_versions")
+	config.Configuration.FileVersionRetention = viper.GetDuration("file_versions.retention")
+	config.Configuration.FileVersionPruneInterval = viper.GetDuration("file_versions.prune_interval")
+	config.Configuration.TrashRetention = viper.GetDuration("trash.retention")
+	config.Configuration.TrashPurgeInterval = viper.GetDuration("trash.purge_interval")
+	if err := viper.UnmarshalKey("webhooks.endpoints", &config.Configuration.Webhooks); err != nil {
+		panic(fmt.Errorf("invalid webhooks.endpoints config: %v", err))
+	}
+	config.Configuration.WebhookMaxAttempts = viper.GetInt("webhooks.max_attempts")
+	config.Configuration.WebhookRetryBackoff = viper.GetDuration("webhooks.retry_backoff")
+	config.Configuration.WebhookMaxRetryBackoff = viper.GetDuration("webhooks.max_retry_backoff")
+	config.Configuration.WebhookTimeout = viper.GetDuration("webhooks.timeout")
+	config.Configuration.WebhookLogRetention = viper.GetDuration("webhooks.log_retention")
+	config.Configuration.WebhookCapacityThreshold = viper.GetFloat64("webhooks.capacity_threshold")
+	config.Configuration.ScrubInterval = viper.GetDuration("scrubber.interval")
+	config.Configuration.ScrubBytesPerSecond = viper.GetInt64("scrubber.bytes_per_second")
+	config.Configuration.ScrubRestoreFromCloud = viper.GetBool("scrubber.restore_from_cloud")
+
+	config.Configuration.DBDriver = viper.GetString("db.driver")
+	config.Configuration.DBPath = viper.GetString("db.path")
+	config.Configuration.DBAutoMigrate = viper.GetBool("db.auto_migrate")
 	config.Configuration.DBHost = viper.GetString("db.host")
 	config.Configuration.DBName = viper.GetString("db.name")
 	config.Configuration.DBPort = viper.GetString("db.port")
@@ -123,14 +157,26 @@ func SetupWorkers() {
 	writemarker.SetupWorkers(root)
 	allocation.StartUpdateWorker(root,
 		config.Configuration.UpdateAllocationsInterval)
+	allocation.StartFileVersionWorker(root,
+		config.Configuration.FileVersionPruneInterval)
+	allocation.StartTrashWorker(root,
+		config.Configuration.TrashPurgeInterval)
+	webhook.StartWorker(root)
+	scrubber.StartWorker(root, config.Configuration.ScrubInterval)
 	// stats.StartEventDispatcher(2)
 }
 
 var fsStore filestore.FileStore //nolint:unused // global which might be needed somewhere
 
 func initEntities() (err error) {
-	fsStore, err = filestore.SetupFSStore(*filesDir + "/files")
-	return err
+	fsStore, err = filestore.SetupStore(config.Configuration.FileStoreBackend, *filesDir+"/files")
+	if err != nil {
+		return err
+	}
+	if err = allocation.SetupCommitJournal(*filesDir + "/journal"); err != nil {
+		return err
+	}
+	return allocation.RecoverCommits(common.GetRootContext())
 }
 
 func initServer() {
@@ -142,6 +188,9 @@ func checkForDBConnection() {
 	var err error
 	for retries < 600 {
 		err = datastore.GetStore().Open()
+		if err == errors.InvalidDBDriverError {
+			break
+		}
 		if err != nil {
 			time.Sleep(1 * time.Second)
 			retries++
@@ -156,6 +205,27 @@ func checkForDBConnection() {
 	}
 }
 
+// migrateSchema applies the pending schema migrations, or only checks that
+// there are none. It never starts over a schema newer than the blobber.
+func migrateSchema(apply bool) {
+	var store = datastore.GetStore()
+	if !apply {
+		if err := store.CheckSchema(); err != nil {
+			Logger.Error("Unexpected schema version. Shutting the server down")
+			panic(err)
+		}
+		return
+	}
+
+	applied, err := store.Migrate()
+	if err != nil {
+		Logger.Error("Error in migrating the database. Shutting the server down")
+		panic(err)
+	}
+	Logger.Info("Schema is up to date", zap.Int("applied", applied),
+		zap.Int64("version", datastore.LatestSchemaVersion()))
+}
+
 func processMinioConfig(reader io.Reader) error {
 	scanner := bufio.NewScanner(reader)
 	more := scanner.Scan()
@@ -223,6 +293,7 @@ func main() {
 	portString := flag.String("port", "", "port")
 	grpcPortString := flag.String("grpc_port", "", "grpc_port")
 	hostname := flag.String("hostname", "", "hostname")
+	migrate := flag.Bool("migrate", false, "apply the pending schema migrations and exit")
 
 	flag.Parse()
 
@@ -240,6 +311,12 @@ func main() {
 	config.Configuration.SignatureScheme = viper.GetString("server_chain.signature_scheme")
 	SetupWorkerConfig()
 
+	if *migrate {
+		checkForDBConnection()
+		migrateSchema(true)
+		return
+	}
+
 	if *filesDir == "" {
 		panic("Please specify --files_dir absolute folder name option where uploaded files can be stored")
 	}
@@ -310,6 +387,7 @@ func main() {
 	chain.SetServerChain(serverChain)
 
 	checkForDBConnection()
+	migrateSchema(config.Configuration.DBAutoMigrate)
 
 	// Initialize after server chain is setup.
 	if err := initEntities(); err != nil {
@@ -359,6 +437,7 @@ func main() {
 			ReadHeaderTimeout: 30 * time.Second,
 			MaxHeaderBytes:    1 << 20,
 			Handler:           rHandler,
+			ConnContext:       common.ConnContext,
 		}
 	} else {
 		server = &http.Server{
@@ -368,6 +447,7 @@ func main() {
 			IdleTimeout:       30 * time.Second,
 			MaxHeaderBytes:    1 << 20,
 			Handler:           rHandler,
+			ConnContext:       common.ConnContext,
 		}
 	}
 	common.HandleShutdown(server)
@@ -20,6 +20,11 @@ const (
 	RENAME_OPERATION       = "rename"
 	COPY_OPERATION         = "copy"
 	UPDATE_ATTRS_OPERATION = "update_attrs"
+	CREATEDIR_OPERATION    = "createdir"
+	MOVE_OPERATION         = "move"
+	RESTORE_OPERATION      = "restore"
+	// the operation of the events of the rollbacks, which are not changes
+	ROLLBACK_OPERATION = "rollback"
 )
 
 const (
@@ -129,6 +134,12 @@ func (cc *AllocationChangeCollector) ComputeProperties() {
 			acp = new(CopyFileChange)
 		case UPDATE_ATTRS_OPERATION:
 			acp = new(AttributesChange)
+		case CREATEDIR_OPERATION:
+			acp = new(CreateDirChange)
+		case MOVE_OPERATION:
+			acp = new(MoveFileChange)
+		case RESTORE_OPERATION:
+			acp = new(RestoreFileChange)
 		}
 
 		if acp == nil {
@@ -150,16 +161,30 @@ func (cc *AllocationChangeCollector) ApplyChanges(ctx context.Context, allocatio
 			return err
 		}
 	}
-	return nil
+	return fitFileVersions(ctx, cc.AllocationID, cc.Size)
 }
 
-func (a *AllocationChangeCollector) CommitToFileStore(ctx context.Context) error {
+// CommitToFileStore moves the uploaded files of the changes to the file store,
+// once recorded in the commit journal with the new allocation root. The
+// objects of the deleted files are removed after the transaction of the
+// context commits, as the journal entry is completed.
+func (a *AllocationChangeCollector) CommitToFileStore(ctx context.Context, allocationRoot string) error {
+	entry := a.journalEntry(allocationRoot)
+	if err := entry.write(); err != nil {
+		return err
+	}
 	for _, change := range a.AllocationChanges {
+		if _, ok := change.(*DeleteFileChange); ok {
+			continue
+		}
 		err := change.CommitToFileStore(ctx)
 		if err != nil {
 			return err
 		}
 	}
+	datastore.GetStore().OnCommit(ctx, func() {
+		entry.complete(context.Background())
+	})
 	return nil
 }
 
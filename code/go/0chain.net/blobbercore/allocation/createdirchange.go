@@ -0,0 +1,97 @@
+package allocation
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/common"
+)
+
+// CreateDirChange creates a directory, and its missing parents. The
+// directory may stay empty.
+type CreateDirChange struct {
+	ConnectionID string `json:"connection_id"`
+	AllocationID string `json:"allocation_id"`
+	Path         string `json:"path"`
+}
+
+func (cd *CreateDirChange) DeleteTempFile() error {
+	return OperationNotApplicable
+}
+
+func (cd *CreateDirChange) ProcessChange(ctx context.Context, change *AllocationChange, allocationRoot string) (*reference.Ref, error) {
+	rootRef, err := reference.GetReferencePath(ctx, cd.AllocationID, cd.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	dirPath := filepath.Clean(cd.Path)
+	parentRef, err := getOrCreateDir(rootRef, filepath.Dir(dirPath))
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range parentRef.Children {
+		if child.Path == dirPath {
+			return nil, common.NewError("invalid_parameters", "Object already exists at path "+dirPath)
+		}
+	}
+	if _, err = getOrCreateDir(rootRef, dirPath); err != nil {
+		return nil, err
+	}
+
+	if _, err = rootRef.CalculateHash(ctx, true); err != nil {
+		return nil, err
+	}
+	return rootRef, nil
+}
+
+// getOrCreateDir walks the reference path from the root to the directory,
+// adding the directories missing in the tree.
+func getOrCreateDir(rootRef *reference.Ref, dirPath string) (*reference.Ref, error) {
+	subDirs := reference.GetSubDirsFromPath(filepath.Clean(dirPath))
+
+	dirRef := rootRef
+	for level, name := range subDirs {
+		var found *reference.Ref
+		for _, child := range dirRef.Children {
+			if child.Name == name {
+				found = child
+				break
+			}
+		}
+		if found != nil && found.Type != reference.DIRECTORY {
+			return nil, common.NewError("invalid_reference_path", "Path contains a file "+found.Path)
+		}
+		if found == nil {
+			found = reference.NewDirectoryRef()
+			found.AllocationID = dirRef.AllocationID
+			found.Path = "/" + strings.Join(subDirs[:level+1], "/")
+			found.ParentPath = "/" + strings.Join(subDirs[:level], "/")
+			found.Name = name
+			found.LookupHash = reference.GetReferenceLookup(dirRef.AllocationID, found.Path)
+			dirRef.AddChild(found)
+		}
+		dirRef = found
+	}
+	return dirRef, nil
+}
+
+func (cd *CreateDirChange) Marshal() (string, error) {
+	ret, err := json.Marshal(cd)
+	if err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+func (cd *CreateDirChange) Unmarshal(input string) error {
+	err := json.Unmarshal([]byte(input), cd)
+	return err
+}
+
+func (cd *CreateDirChange) CommitToFileStore(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,99 @@
+package allocation
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/encryption"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const treeAllocationID = "tree_alloc"
+
+// storeTree stores the files with their parent directories, and the hashes
+// of the tree.
+func storeTree(t *testing.T, files ...string) context.Context {
+	datastore.OpenTheSQLiteStore(t)
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	db := datastore.GetStore().GetTransaction(ctx)
+
+	stored := map[string]bool{}
+	var store func(path string, refType string)
+	store = func(path string, refType string) {
+		if stored[path] {
+			return
+		}
+		stored[path] = true
+		ref := &reference.Ref{Type: refType, AllocationID: treeAllocationID,
+			Name: filepath.Base(path), Path: path,
+			LookupHash: reference.GetReferenceLookup(treeAllocationID, path)}
+		if path != "/" {
+			store(filepath.Dir(path), reference.DIRECTORY)
+			ref.ParentPath = filepath.Dir(path)
+		}
+		ref.PathLevel = len(reference.GetSubDirsFromPath(path)) + 1
+		if refType == reference.FILE {
+			ref.Size = 10
+			ref.ContentHash = encryption.Hash(path)
+			ref.MerkleRoot = ref.ContentHash
+		}
+		require.NoError(t, db.Create(ref).Error)
+	}
+	for _, f := range files {
+		store(f, reference.FILE)
+	}
+
+	tree, err := reference.GetObjectTree(ctx, treeAllocationID, "/")
+	require.NoError(t, err)
+	_, err = tree.CalculateHash(ctx, true)
+	require.NoError(t, err)
+	return ctx
+}
+
+// requireTreeHash checks the stored hashes against the ones computed from
+// the whole tree.
+func requireTreeHash(t *testing.T, ctx context.Context, rootRef *reference.Ref) {
+	tree, err := reference.GetObjectTree(ctx, treeAllocationID, "/")
+	require.NoError(t, err)
+	stored := tree.Hash
+	_, err = tree.CalculateHash(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, tree.Hash, stored)
+	assert.Equal(t, tree.Hash, rootRef.Hash)
+}
+
+func TestCreateDirChange_ProcessChange(t *testing.T) {
+	ctx := storeTree(t, "/file.txt")
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	cd := &CreateDirChange{AllocationID: treeAllocationID, Path: "/a/b"}
+	rootRef, err := cd.ProcessChange(ctx, nil, "")
+	require.NoError(t, err)
+	requireTreeHash(t, ctx, rootRef)
+
+	dirRef, err := reference.GetReference(ctx, treeAllocationID, "/a/b")
+	require.NoError(t, err)
+	assert.Equal(t, reference.DIRECTORY, dirRef.Type)
+	assert.Equal(t, "/a", dirRef.ParentPath)
+	assert.Equal(t, encryption.Hash(""), dirRef.Hash)
+
+	// an empty directory is kept by the next changes
+	cd = &CreateDirChange{AllocationID: treeAllocationID, Path: "/c"}
+	rootRef, err = cd.ProcessChange(ctx, nil, "")
+	require.NoError(t, err)
+	requireTreeHash(t, ctx, rootRef)
+	_, err = reference.GetReference(ctx, treeAllocationID, "/a/b")
+	require.NoError(t, err)
+
+	for _, path := range []string{"/a/b", "/file.txt", "/file.txt/d"} {
+		cd = &CreateDirChange{AllocationID: treeAllocationID, Path: path}
+		_, err = cd.ProcessChange(ctx, nil, "")
+		assert.Error(t, err, path)
+	}
+}
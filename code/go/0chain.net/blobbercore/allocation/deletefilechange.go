@@ -68,6 +68,7 @@ func (nf *DeleteFileChange) ProcessChange(ctx context.Context, change *Allocatio
 			if child.Type == reference.FILE {
 				nf.ContentHash[child.ThumbnailHash] = true
 				nf.ContentHash[child.ContentHash] = true
+				nf.deleteFileVersions(ctx, child)
 			} else {
 				nf.processChildren(ctx, affectedRef)
 			}
@@ -94,12 +95,33 @@ func (nf *DeleteFileChange) processChildren(ctx context.Context, curRef *referen
 		if childRef.Type == reference.FILE {
 			nf.ContentHash[childRef.ThumbnailHash] = true
 			nf.ContentHash[childRef.ContentHash] = true
+			nf.deleteFileVersions(ctx, childRef)
 		} else if childRef.Type == reference.DIRECTORY {
 			nf.processChildren(ctx, childRef)
 		}
 	}
 }
 
+// deleteFileVersions deletes the versions of the deleted file, whose objects
+// go with the ones of the file. The versions of a file kept in the trash are
+// deleted once it is purged.
+func (nf *DeleteFileChange) deleteFileVersions(ctx context.Context, ref *reference.Ref) {
+	if TrashEnabled() {
+		return
+	}
+	versions, err := reference.DeleteFileVersions(ctx, ref.ID)
+	if err == nil {
+		err = accountFileVersions(ctx, nf.AllocationID, 0, versions)
+	}
+	if err != nil {
+		Logger.Error("DeleteFileVersions", zap.Int64("ref_id", ref.ID), zap.Error(err))
+		return
+	}
+	for _, contentHash := range versionObjects(versions) {
+		nf.ContentHash[contentHash] = true
+	}
+}
+
 func (nf *DeleteFileChange) Marshal() (string, error) {
 	ret, err := json.Marshal(nf)
 	if err != nil {
@@ -118,16 +140,33 @@ func (nf *DeleteFileChange) DeleteTempFile() error {
 }
 
 func (nf *DeleteFileChange) CommitToFileStore(ctx context.Context) error {
-	db := datastore.GetStore().GetTransaction(ctx)
 	for contenthash := range nf.ContentHash {
-		var count int64
-		err := db.Table((&reference.Ref{}).TableName()).Where(&reference.Ref{ThumbnailHash: contenthash}).Or(&reference.Ref{ContentHash: contenthash}).Count(&count).Error
-		if err == nil && count == 0 {
-			Logger.Info("Deleting content file", zap.String("content_hash", contenthash))
-			if err := filestore.GetFileStore().DeleteFile(nf.AllocationID, contenthash); err != nil {
-				Logger.Error("FileStore_DeleteFile", zap.String("allocation_id", nf.AllocationID), zap.Error(err))
-			}
-		}
+		deleteUnreferencedObject(ctx, nf.AllocationID, contenthash)
 	}
 	return nil
 }
+
+// deleteUnreferencedObject deletes the object of the content hash from the
+// file store, unless a file, a file in the trash, a file version or a
+// reference snapshot still refers to it.
+func deleteUnreferencedObject(ctx context.Context, allocationID, contenthash string) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var count int64
+	err := db.Model(&reference.Ref{}).Where(&reference.Ref{ThumbnailHash: contenthash}).Or(&reference.Ref{ContentHash: contenthash}).Count(&count).Error
+	if err != nil || count > 0 {
+		return
+	}
+	if inSnapshot, err := reference.IsSnapshotObject(ctx, allocationID, contenthash); err != nil || inSnapshot {
+		return
+	}
+	if inVersion, err := reference.IsVersionObject(ctx, allocationID, contenthash); err != nil || inVersion {
+		return
+	}
+	if inTrash, err := IsTrashObject(ctx, allocationID, contenthash); err != nil || inTrash {
+		return
+	}
+	Logger.Info("Deleting content file", zap.String("content_hash", contenthash))
+	if err := filestore.GetFileStore().DeleteFile(allocationID, contenthash); err != nil {
+		Logger.Error("FileStore_DeleteFile", zap.String("allocation_id", allocationID), zap.Error(err))
+	}
+}
@@ -40,6 +40,12 @@ type Allocation struct {
 
 	// Used for 3rd party/payer operations
 	PayerID string `gorm:"column:payer_id"`
+
+	// MaxFileVersions is the number of previous versions kept per updated
+	// file, and FileVersionRetention how long they are kept, in seconds.
+	// Either limit is off when zero, the versions are off when both are.
+	MaxFileVersions      int   `gorm:"column:max_file_versions"`
+	FileVersionRetention int64 `gorm:"column:file_version_retention"`
 }
 
 func (Allocation) TableName() string {
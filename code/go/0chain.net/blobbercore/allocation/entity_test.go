@@ -0,0 +1,32 @@
+package allocation
+
+import (
+	"testing"
+
+	"0chain.net/blobbercore/datastore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetReadPools(t *testing.T) {
+	datastore.OpenTheSQLiteStore(t)
+	defer datastore.GetStore().Close()
+	db := datastore.GetStore().GetDB()
+
+	require.NoError(t, SetReadPools(db, "client", "alloc", "blobber", []*ReadPool{
+		{PoolID: "pool1", ClientID: "client", BlobberID: "blobber", AllocationID: "alloc", Balance: 10, ExpireAt: 100},
+		{PoolID: "pool2", ClientID: "client", BlobberID: "blobber", AllocationID: "alloc", Balance: 20, ExpireAt: 100},
+	}))
+
+	// the old pools of the client are replaced
+	require.NoError(t, SetReadPools(db, "client", "alloc", "blobber", []*ReadPool{
+		{PoolID: "pool2", ClientID: "client", BlobberID: "blobber", AllocationID: "alloc", Balance: 15, ExpireAt: 100},
+	}))
+
+	var rps []*ReadPool
+	require.NoError(t, db.Find(&rps).Error)
+	require.Len(t, rps, 1)
+	assert.Equal(t, "pool2", rps[0].PoolID)
+	assert.Equal(t, int64(15), rps[0].Balance)
+}
@@ -0,0 +1,71 @@
+package allocation
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+
+	"0chain.net/blobbercore/feed"
+	"0chain.net/blobbercore/reference"
+
+	"gorm.io/gorm"
+)
+
+// Events returns the events of the changes of the connection committed with
+// the allocation root. The hashes are the ones of the objects once all the
+// changes are applied.
+func (cc *AllocationChangeCollector) Events(ctx context.Context, allocationRoot string) ([]*feed.Event, error) {
+	events := make([]*feed.Event, 0, len(cc.AllocationChanges))
+	for idx, change := range cc.AllocationChanges {
+		event := changeEvent(change)
+		if event == nil {
+			continue
+		}
+		event.AllocationRoot = allocationRoot
+		event.Operation = cc.Changes[idx].Operation
+		if _, ok := change.(*DeleteFileChange); !ok {
+			ref, err := reference.GetReference(ctx, cc.AllocationID, event.Path)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, err
+			}
+			if ref != nil {
+				event.Hash = ref.Hash
+			}
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// changeEvent returns the event of the change, with its paths only.
+func changeEvent(change AllocationChangeProcessor) *feed.Event {
+	switch c := change.(type) {
+	case *NewFileChange:
+		return pathEvent(c.Path, "")
+	case *UpdateFileChange:
+		return pathEvent(c.Path, "")
+	case *DeleteFileChange:
+		return pathEvent(c.Path, "")
+	case *AttributesChange:
+		return pathEvent(c.Path, "")
+	case *CreateDirChange:
+		return pathEvent(c.Path, "")
+	case *RestoreFileChange:
+		return pathEvent(c.Path, "")
+	case *RenameFileChange:
+		return pathEvent(filepath.Join(filepath.Dir(c.Path), c.NewName), c.Path)
+	case *CopyFileChange:
+		return pathEvent(filepath.Join(c.DestPath, filepath.Base(c.SrcPath)), c.SrcPath)
+	case *MoveFileChange:
+		return pathEvent(filepath.Join(c.DestPath, filepath.Base(c.SrcPath)), c.SrcPath)
+	}
+	return nil
+}
+
+func pathEvent(path, srcPath string) *feed.Event {
+	event := &feed.Event{Path: filepath.Clean(path)}
+	if srcPath != "" {
+		event.SrcPath = filepath.Clean(srcPath)
+	}
+	return event
+}
@@ -0,0 +1,50 @@
+package allocation
+
+import (
+	"testing"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/reference"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocationChangeCollector_Events(t *testing.T) {
+	ctx := storeTree(t, "/a/file.txt", "/b/x.txt")
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+	require.NoError(t, datastore.GetStore().GetTransaction(ctx).Create(
+		&Allocation{ID: treeAllocationID, Tx: treeAllocationID}).Error)
+
+	cc := &AllocationChangeCollector{AllocationID: treeAllocationID}
+	cc.AddChange(&AllocationChange{Operation: CREATEDIR_OPERATION},
+		&CreateDirChange{AllocationID: treeAllocationID, Path: "/c"})
+	cc.AddChange(&AllocationChange{Operation: RENAME_OPERATION},
+		&RenameFileChange{AllocationID: treeAllocationID, Path: "/a/file.txt", NewName: "new.txt"})
+	cc.AddChange(&AllocationChange{Operation: MOVE_OPERATION},
+		&MoveFileChange{AllocationID: treeAllocationID, SrcPath: "/b/x.txt", DestPath: "/c/"})
+	require.NoError(t, cc.ApplyChanges(ctx, "root"))
+
+	events, err := cc.Events(ctx, "root")
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	hash := func(path string) string {
+		ref, err := reference.GetReference(ctx, treeAllocationID, path)
+		require.NoError(t, err)
+		return ref.Hash
+	}
+	for i, want := range []struct {
+		operation, path, srcPath string
+	}{
+		{CREATEDIR_OPERATION, "/c", ""},
+		{RENAME_OPERATION, "/a/new.txt", "/a/file.txt"},
+		{MOVE_OPERATION, "/c/x.txt", "/b/x.txt"},
+	} {
+		assert.Equal(t, "root", events[i].AllocationRoot)
+		assert.Equal(t, want.operation, events[i].Operation)
+		assert.Equal(t, want.path, events[i].Path)
+		assert.Equal(t, want.srcPath, events[i].SrcPath)
+		assert.Equal(t, hash(want.path), events[i].Hash)
+	}
+}
@@ -0,0 +1,225 @@
+package allocation
+
+import (
+	"context"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/lock"
+	. "0chain.net/core/logging"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// KeepsFileVersions tells whether the previous versions of the updated files
+// are kept.
+func (a *Allocation) KeepsFileVersions() bool {
+	return a.MaxFileVersions > 0 || a.FileVersionRetention > 0
+}
+
+// fileVersionsExpiry returns the creation time before which the versions are
+// past their retention, zero if kept with no time limit.
+func (a *Allocation) fileVersionsExpiry() time.Time {
+	if a.FileVersionRetention <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(-time.Duration(a.FileVersionRetention) * time.Second)
+}
+
+// keepFileVersion keeps the content of the file about to be replaced by an
+// update as its latest version, when the allocation keeps versions, and
+// prunes the versions beyond its limits. The ones beyond the size of the
+// allocation are pruned once all the changes are applied, by fitFileVersions.
+func keepFileVersion(ctx context.Context, ref *reference.Ref) error {
+	a := new(Allocation)
+	db := datastore.GetStore().GetTransaction(ctx)
+	err := db.Select("id, max_file_versions, file_version_retention").
+		Where("id = ?", ref.AllocationID).First(a).Error
+	if err != nil {
+		return err
+	}
+	if !a.KeepsFileVersions() {
+		return nil
+	}
+
+	fv, err := reference.AddFileVersion(ctx, ref)
+	if err != nil {
+		return err
+	}
+	pruned, err := reference.PruneFileVersions(ctx, ref.ID, a.MaxFileVersions, a.fileVersionsExpiry())
+	if err != nil {
+		return err
+	}
+	if err = accountFileVersions(ctx, a.ID, fv.Size, pruned); err != nil {
+		return err
+	}
+	deleteObjectsOnCommit(ctx, a.ID, versionObjects(pruned))
+	return nil
+}
+
+// fitFileVersions prunes the oldest versions of the allocation, whatever
+// their file, which the size of the changes about to be added would push
+// beyond the size of the allocation with the blobber.
+func fitFileVersions(ctx context.Context, allocationID string, size int64) error {
+	a := new(Allocation)
+	db := datastore.GetStore().GetTransaction(ctx)
+	err := db.Select("id, blobber_size, blobber_size_used, max_file_versions, file_version_retention").
+		Where("id = ?", allocationID).First(a).Error
+	if err != nil {
+		return err
+	}
+	excess := a.BlobberSizeUsed + size - a.BlobberSize
+	if !a.KeepsFileVersions() || excess <= 0 {
+		return nil
+	}
+	pruned, err := reference.PruneOldestFileVersions(ctx, a.ID, excess)
+	if err != nil {
+		return err
+	}
+	if err = accountFileVersions(ctx, a.ID, 0, pruned); err != nil {
+		return err
+	}
+	deleteObjectsOnCommit(ctx, a.ID, versionObjects(pruned))
+	return nil
+}
+
+// accountFileVersions adds the size of the versions kept, less the one of the
+// versions deleted, to the sizes used by the allocation.
+func accountFileVersions(ctx context.Context, allocationID string, kept int64,
+	deleted []*reference.FileVersion) error {
+
+	size := kept
+	for _, fv := range deleted {
+		size -= fv.Size
+	}
+	if size == 0 {
+		return nil
+	}
+	db := datastore.GetStore().GetTransaction(ctx)
+	return db.Model(&Allocation{}).Where("id = ?", allocationID).
+		Updates(map[string]interface{}{
+			"blobber_size_used": gorm.Expr("blobber_size_used + ?", size),
+			"used_size":         gorm.Expr("used_size + ?", size),
+		}).Error
+}
+
+func versionObjects(versions []*reference.FileVersion) []string {
+	var objects []string
+	for _, fv := range versions {
+		objects = append(objects, fv.ContentHash)
+		if fv.ThumbnailHash != "" {
+			objects = append(objects, fv.ThumbnailHash)
+		}
+	}
+	return objects
+}
+
+// deleteObjectsOnCommit deletes the objects no longer referred to once the
+// transaction of the context commits.
+func deleteObjectsOnCommit(ctx context.Context, allocationID string, objects []string) {
+	if len(objects) == 0 {
+		return
+	}
+	datastore.GetStore().OnCommit(ctx, func() {
+		ctx := datastore.GetStore().CreateTransaction(context.Background())
+		for _, contentHash := range objects {
+			deleteUnreferencedObject(ctx, allocationID, contentHash)
+		}
+		datastore.GetStore().GetTransaction(ctx).Rollback()
+	})
+}
+
+// SetFileVersioning changes the limits of the file versions kept, and prunes
+// the versions beyond the new ones.
+func (a *Allocation) SetFileVersioning(ctx context.Context, maxVersions int, retention int64) error {
+	db := datastore.GetStore().GetTransaction(ctx)
+	err := db.Model(a).Updates(map[string]interface{}{
+		"max_file_versions":      maxVersions,
+		"file_version_retention": retention,
+	}).Error
+	if err != nil {
+		return err
+	}
+	a.MaxFileVersions, a.FileVersionRetention = maxVersions, retention
+
+	var refIDs []int64
+	err = db.Model(&reference.FileVersion{}).Where("allocation_id = ?", a.ID).
+		Distinct().Pluck("ref_id", &refIDs).Error
+	if err != nil {
+		return err
+	}
+	var pruned []*reference.FileVersion
+	for _, refID := range refIDs {
+		var versions []*reference.FileVersion
+		if a.KeepsFileVersions() {
+			versions, err = reference.PruneFileVersions(ctx, refID, a.MaxFileVersions, a.fileVersionsExpiry())
+		} else {
+			versions, err = reference.DeleteFileVersions(ctx, refID)
+		}
+		if err != nil {
+			return err
+		}
+		pruned = append(pruned, versions...)
+	}
+	if err = accountFileVersions(ctx, a.ID, 0, pruned); err != nil {
+		return err
+	}
+	deleteObjectsOnCommit(ctx, a.ID, versionObjects(pruned))
+	return nil
+}
+
+// StartFileVersionWorker prunes the file versions past the retention of their
+// allocations at every interval.
+func StartFileVersionWorker(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		tk := time.NewTicker(interval)
+		defer tk.Stop()
+		for {
+			select {
+			case <-tk.C:
+				pruneExpiredFileVersions(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func pruneExpiredFileVersions(ctx context.Context) {
+	var allocs []*Allocation
+	err := datastore.GetStore().GetDB().
+		Where("file_version_retention > 0").Find(&allocs).Error
+	if err != nil {
+		Logger.Error("Finding the allocations keeping file versions", zap.Error(err))
+		return
+	}
+	for _, a := range allocs {
+		if err := a.pruneExpiredFileVersions(ctx); err != nil {
+			Logger.Error("Pruning the file versions",
+				zap.String("allocation_id", a.ID), zap.Error(err))
+		}
+	}
+}
+
+func (a *Allocation) pruneExpiredFileVersions(ctx context.Context) error {
+	mutex := lock.GetMutex(a.TableName(), a.ID)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	ctx = datastore.GetStore().CreateTransaction(ctx)
+	pruned, err := reference.PruneExpiredFileVersions(ctx, a.ID, a.fileVersionsExpiry())
+	if err == nil {
+		err = accountFileVersions(ctx, a.ID, 0, pruned)
+	}
+	if err != nil {
+		datastore.GetStore().GetTransaction(ctx).Rollback()
+		return err
+	}
+	deleteObjectsOnCommit(ctx, a.ID, versionObjects(pruned))
+	return datastore.GetStore().Commit(ctx)
+}
@@ -0,0 +1,209 @@
+package allocation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/internal/filestoretest"
+	"0chain.net/blobbercore/reference"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storeVersionedFile stores the file of an allocation keeping versions, with
+// its content in the file store.
+func storeVersionedFile(t *testing.T, maxVersions int) (context.Context, *filestore.FileInputData) {
+	ctx := storeTree(t, "/file.txt")
+	_, err := filestore.SetupStore(filestore.MemoryStoreBackend, "")
+	require.NoError(t, err)
+	db := datastore.GetStore().GetTransaction(ctx)
+	require.NoError(t, db.Create(&Allocation{ID: treeAllocationID, Tx: treeAllocationID,
+		BlobberSizeUsed: 10, UsedSize: 10, MaxFileVersions: maxVersions}).Error)
+
+	fileData := &filestore.FileInputData{Name: "file.txt", Path: "/file.txt"}
+	out, err := filestore.GetFileStore().WriteFile(treeAllocationID, fileData,
+		filestoretest.NewMemFile([]byte("content")), "conn")
+	require.NoError(t, err)
+	fileData.Hash = out.ContentHash
+	_, err = filestore.GetFileStore().CommitWrite(treeAllocationID, fileData, "conn")
+	require.NoError(t, err)
+	require.NoError(t, db.Model(&reference.Ref{}).Where("path = ?", "/file.txt").
+		Update("content_hash", fileData.Hash).Error)
+	return ctx, fileData
+}
+
+func updateFile(t *testing.T, ctx context.Context, contentHash string) {
+	uf := &UpdateFileChange{NewFileChange{AllocationID: treeAllocationID,
+		Path: "/file.txt", Hash: contentHash, Size: 10}}
+	_, err := uf.ProcessChange(ctx, nil, "")
+	require.NoError(t, err)
+}
+
+func requireSizeUsed(t *testing.T, ctx context.Context, size int64) {
+	a := new(Allocation)
+	require.NoError(t, datastore.GetStore().GetTransaction(ctx).
+		Where("id = ?", treeAllocationID).First(a).Error)
+	assert.Equal(t, size, a.BlobberSizeUsed)
+	assert.Equal(t, size, a.UsedSize)
+}
+
+func TestUpdateFileChange_KeepsVersions(t *testing.T) {
+	ctx, fileData := storeVersionedFile(t, 2)
+	defer datastore.GetStore().Close()
+
+	for _, contentHash := range []string{"hash2", "hash3", "hash4"} {
+		updateFile(t, ctx, contentHash)
+	}
+	ref, err := reference.GetReference(ctx, treeAllocationID, "/file.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "hash4", ref.ContentHash)
+
+	versions, err := reference.GetFileVersions(ctx, ref.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, int64(3), versions[0].Version)
+	assert.Equal(t, "hash3", versions[0].ContentHash)
+	assert.Equal(t, "hash2", versions[1].ContentHash)
+	requireSizeUsed(t, ctx, 30)
+
+	// the content of the first version pruned goes once committed
+	assert.True(t, objectExists(treeAllocationID, fileData))
+	require.NoError(t, datastore.GetStore().Commit(ctx))
+	assert.False(t, objectExists(treeAllocationID, fileData))
+}
+
+func TestDeleteFileChange_DeletesVersions(t *testing.T) {
+	ctx, fileData := storeVersionedFile(t, 2)
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	updateFile(t, ctx, "hash2")
+	requireSizeUsed(t, ctx, 20)
+	ref, err := reference.GetReference(ctx, treeAllocationID, "/file.txt")
+	require.NoError(t, err)
+
+	df := &DeleteFileChange{AllocationID: treeAllocationID, Path: "/file.txt", Hash: ref.Hash}
+	_, err = df.ProcessChange(ctx, nil, "")
+	require.NoError(t, err)
+	assert.True(t, df.ContentHash[fileData.Hash])
+	versions, err := reference.GetFileVersions(ctx, ref.ID)
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+	requireSizeUsed(t, ctx, 10)
+}
+
+func TestAllocation_SetFileVersioning(t *testing.T) {
+	ctx, _ := storeVersionedFile(t, 3)
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	for _, contentHash := range []string{"hash2", "hash3", "hash4"} {
+		updateFile(t, ctx, contentHash)
+	}
+	requireSizeUsed(t, ctx, 40)
+	ref, err := reference.GetReference(ctx, treeAllocationID, "/file.txt")
+	require.NoError(t, err)
+
+	a := &Allocation{ID: treeAllocationID}
+	require.NoError(t, a.SetFileVersioning(ctx, 1, 0))
+	versions, err := reference.GetFileVersions(ctx, ref.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, "hash3", versions[0].ContentHash)
+	requireSizeUsed(t, ctx, 20)
+
+	require.NoError(t, a.SetFileVersioning(ctx, 0, 0))
+	versions, err = reference.GetFileVersions(ctx, ref.ID)
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+	requireSizeUsed(t, ctx, 10)
+
+	// the next updates replace the content
+	updateFile(t, ctx, "hash5")
+	requireSizeUsed(t, ctx, 10)
+}
+
+func TestUpdateFileChange_VersionNumbersNotReused(t *testing.T) {
+	ctx, _ := storeVersionedFile(t, 2)
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	updateFile(t, ctx, "hash2")
+	updateFile(t, ctx, "hash3")
+	a := &Allocation{ID: treeAllocationID}
+	require.NoError(t, a.SetFileVersioning(ctx, 0, 0))
+	require.NoError(t, a.SetFileVersioning(ctx, 2, 0))
+
+	updateFile(t, ctx, "hash4")
+	ref, err := reference.GetReference(ctx, treeAllocationID, "/file.txt")
+	require.NoError(t, err)
+	versions, err := reference.GetFileVersions(ctx, ref.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, int64(3), versions[0].Version)
+	assert.Equal(t, "hash3", versions[0].ContentHash)
+	assert.Equal(t, int64(3), ref.LastVersion)
+}
+
+func TestDeleteUnreferencedObject_DeletedRef(t *testing.T) {
+	ctx, fileData := storeVersionedFile(t, 0)
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	ref, err := reference.GetReference(ctx, treeAllocationID, "/file.txt")
+	require.NoError(t, err)
+	require.NoError(t, reference.DeleteReference(ctx, ref.ID, ref.PathHash))
+
+	// kept while the deleted file is in the trash
+	restore := setTrashRetention(time.Hour)
+	deleteUnreferencedObject(ctx, treeAllocationID, fileData.Hash)
+	restore()
+	assert.True(t, objectExists(treeAllocationID, fileData))
+
+	// the reference of a deleted file does not hold the object otherwise
+	deleteUnreferencedObject(ctx, treeAllocationID, fileData.Hash)
+	assert.False(t, objectExists(treeAllocationID, fileData))
+}
+
+func TestApplyChanges_FitsVersionsInAllocationSize(t *testing.T) {
+	ctx, fileData := storeVersionedFile(t, 3)
+	defer datastore.GetStore().Close()
+	require.NoError(t, datastore.GetStore().GetTransaction(ctx).Model(&Allocation{}).
+		Where("id = ?", treeAllocationID).Update("blobber_size", 30).Error)
+
+	cc := &AllocationChangeCollector{AllocationID: treeAllocationID}
+	for _, contentHash := range []string{"hash2", "hash3", "hash4"} {
+		cc.AddChange(&AllocationChange{Operation: UPDATE_OPERATION},
+			&UpdateFileChange{NewFileChange{AllocationID: treeAllocationID,
+				Path: "/file.txt", Hash: contentHash, Size: 10}})
+	}
+	require.NoError(t, cc.ApplyChanges(ctx, ""))
+
+	// the oldest version doesn't fit, the limit of 3 kept apart
+	ref, err := reference.GetReference(ctx, treeAllocationID, "/file.txt")
+	require.NoError(t, err)
+	versions, err := reference.GetFileVersions(ctx, ref.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "hash3", versions[0].ContentHash)
+	assert.Equal(t, "hash2", versions[1].ContentHash)
+	requireSizeUsed(t, ctx, 30)
+
+	// nor do the next ones, the changes adding 10 bytes
+	cc.Size = 10
+	cc.Changes, cc.AllocationChanges = nil, nil
+	require.NoError(t, cc.ApplyChanges(ctx, ""))
+	versions, err = reference.GetFileVersions(ctx, ref.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, "hash3", versions[0].ContentHash)
+	requireSizeUsed(t, ctx, 20)
+
+	assert.True(t, objectExists(treeAllocationID, fileData))
+	require.NoError(t, datastore.GetStore().Commit(ctx))
+	assert.False(t, objectExists(treeAllocationID, fileData))
+}
@@ -0,0 +1,255 @@
+package allocation
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	. "0chain.net/core/logging"
+
+	"go.uber.org/zap"
+)
+
+const journalEntryExt = ".json"
+
+// journalDir is the directory of the commit journal, which is disabled while
+// not set up.
+var journalDir string
+
+// SetupCommitJournal creates the directory of the commit journal.
+func SetupCommitJournal(dir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return common.NewError("commit_journal_error", err.Error())
+	}
+	journalDir = dir
+	return nil
+}
+
+// CommitJournalEntry records the file store operations of a commit before
+// they start, so that a commit interrupted by a stop of the blobber is
+// settled on start by RecoverCommits.
+type CommitJournalEntry struct {
+	AllocationID string `json:"allocation_id"`
+	ConnectionID string `json:"connection_id"`
+	// AllocationRoot is the root of the allocation once the commit is in the
+	// metadata.
+	AllocationRoot string `json:"allocation_root"`
+	// Writes are the temp files moved to the objects before the metadata
+	// transaction commits.
+	Writes []*filestore.FileInputData `json:"writes"`
+	// Deletes are the content hashes of the objects deleted after the
+	// metadata transaction commits, unless still referred to.
+	Deletes []string `json:"deletes"`
+}
+
+func (a *AllocationChangeCollector) journalEntry(allocationRoot string) *CommitJournalEntry {
+	entry := &CommitJournalEntry{
+		AllocationID:   a.AllocationID,
+		ConnectionID:   a.ConnectionID,
+		AllocationRoot: allocationRoot,
+	}
+	for _, change := range a.AllocationChanges {
+		switch c := change.(type) {
+		case *NewFileChange:
+			entry.addWrites(c)
+		case *UpdateFileChange:
+			entry.addWrites(&c.NewFileChange)
+		case *DeleteFileChange:
+			for contentHash := range c.ContentHash {
+				entry.Deletes = append(entry.Deletes, contentHash)
+			}
+		}
+	}
+	return entry
+}
+
+func (e *CommitJournalEntry) addWrites(nf *NewFileChange) {
+	e.Writes = append(e.Writes, &filestore.FileInputData{Name: nf.Filename,
+		Path: nf.Path, Hash: nf.Hash, MimeType: nf.MimeType})
+	if nf.ThumbnailSize > 0 {
+		e.Writes = append(e.Writes, &filestore.FileInputData{
+			Name: nf.ThumbnailFilename, Path: nf.Path, Hash: nf.ThumbnailHash})
+	}
+}
+
+func (e *CommitJournalEntry) fileName() string {
+	return filepath.Join(journalDir,
+		encryption.Hash(e.AllocationID+":"+e.ConnectionID)+journalEntryExt)
+}
+
+// write stores the entry durably, replacing a previous one of the connection.
+func (e *CommitJournalEntry) write() error {
+	if journalDir == "" {
+		return nil
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return common.NewError("commit_journal_error", err.Error())
+	}
+	name := e.fileName()
+	f, err := ioutil.TempFile(journalDir, "entry")
+	if err != nil {
+		return common.NewError("commit_journal_error", err.Error())
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), name)
+	}
+	if err == nil {
+		err = syncDir(journalDir)
+	}
+	if err != nil {
+		return common.NewError("commit_journal_error", err.Error())
+	}
+	return nil
+}
+
+func (e *CommitJournalEntry) remove() {
+	if journalDir == "" {
+		return
+	}
+	if err := os.Remove(e.fileName()); err != nil && !os.IsNotExist(err) {
+		Logger.Error("Error removing the commit journal entry",
+			zap.String("connection_id", e.ConnectionID), zap.Error(err))
+	}
+}
+
+// complete deletes the objects of the committed entry and drops it.
+func (e *CommitJournalEntry) complete(ctx context.Context) {
+	if len(e.Deletes) > 0 {
+		ctx = datastore.GetStore().CreateTransaction(ctx)
+		for _, contentHash := range e.Deletes {
+			deleteUnreferencedObject(ctx, e.AllocationID, contentHash)
+		}
+		datastore.GetStore().GetTransaction(ctx).Rollback()
+	}
+	e.remove()
+}
+
+// RecoverCommits settles the commits left in the journal. A commit found in
+// the metadata is replayed: the temp files not moved yet are, and the objects
+// of the deleted files are removed. Otherwise it is rolled back: the objects
+// it moved are removed unless referred to, and its connection is dropped, to
+// be uploaded again. An entry failing to settle is kept for the next start.
+func RecoverCommits(ctx context.Context) error {
+	if journalDir == "" {
+		return nil
+	}
+	names, err := filepath.Glob(filepath.Join(journalDir, "*"+journalEntryExt))
+	if err != nil {
+		return common.NewError("commit_journal_error", err.Error())
+	}
+	for _, name := range names {
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return common.NewError("commit_journal_error", err.Error())
+		}
+		entry := new(CommitJournalEntry)
+		if err := json.Unmarshal(data, entry); err != nil {
+			Logger.Error("Invalid commit journal entry", zap.String("file", name), zap.Error(err))
+			continue
+		}
+		if err := entry.recover(ctx); err != nil {
+			Logger.Error("Error recovering the commit",
+				zap.String("allocation_id", entry.AllocationID),
+				zap.String("connection_id", entry.ConnectionID), zap.Error(err))
+			continue
+		}
+		entry.remove()
+	}
+	return nil
+}
+
+func (e *CommitJournalEntry) recover(ctx context.Context) (err error) {
+	ctx = datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(ctx)
+	defer func() {
+		if err != nil {
+			db.Rollback()
+		}
+	}()
+
+	var allocationRoot string
+	err = db.Model(&Allocation{}).Select("allocation_root").
+		Where("id = ?", e.AllocationID).Row().Scan(&allocationRoot)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if err == nil && allocationRoot == e.AllocationRoot {
+		Logger.Info("Replaying the commit", zap.String("allocation_id", e.AllocationID),
+			zap.String("connection_id", e.ConnectionID))
+		if err = e.replay(ctx); err != nil {
+			return err
+		}
+	} else {
+		Logger.Info("Rolling the commit back", zap.String("allocation_id", e.AllocationID),
+			zap.String("connection_id", e.ConnectionID))
+		if err = e.rollback(ctx); err != nil {
+			return err
+		}
+	}
+	return db.Commit().Error
+}
+
+func (e *CommitJournalEntry) replay(ctx context.Context) error {
+	fs := filestore.GetFileStore()
+	for _, w := range e.Writes {
+		if _, err := fs.CommitWrite(e.AllocationID, w, e.ConnectionID); err != nil {
+			// moved before the stop
+			if !objectExists(e.AllocationID, w) {
+				return err
+			}
+		}
+	}
+	for _, contentHash := range e.Deletes {
+		deleteUnreferencedObject(ctx, e.AllocationID, contentHash)
+	}
+	return nil
+}
+
+func (e *CommitJournalEntry) rollback(ctx context.Context) error {
+	fs := filestore.GetFileStore()
+	for _, w := range e.Writes {
+		// moved or not, the temp file is of no use anymore
+		_ = fs.DeleteTempFile(e.AllocationID, w, e.ConnectionID)
+		deleteUnreferencedObject(ctx, e.AllocationID, w.Hash)
+	}
+	db := datastore.GetStore().GetTransaction(ctx)
+	return db.Model(&AllocationChangeCollector{}).
+		Where("connection_id = ?", e.ConnectionID).
+		Update("status", DeletedConnection).Error
+}
+
+func objectExists(allocationID string, fileData *filestore.FileInputData) bool {
+	r, _, err := filestore.GetFileStore().GetFileReader(allocationID,
+		&filestore.FileInputData{Hash: fileData.Hash})
+	if err != nil {
+		return false
+	}
+	r.Close()
+	return true
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
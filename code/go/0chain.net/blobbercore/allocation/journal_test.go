@@ -0,0 +1,135 @@
+package allocation
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/internal/filestoretest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const journalAllocationID = "journal_alloc"
+
+// setupJournal opens the stores and the journal, with the allocation at the
+// given root and an open connection holding an uploaded file.
+func setupJournal(t *testing.T, allocationRoot string) (dir string,
+	fileData *filestore.FileInputData) {
+
+	datastore.OpenTheSQLiteStore(t)
+	_, err := filestore.SetupStore(filestore.MemoryStoreBackend, "")
+	require.NoError(t, err)
+	dir, err = ioutil.TempDir("", "journal")
+	require.NoError(t, err)
+	require.NoError(t, SetupCommitJournal(dir))
+
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	db := datastore.GetStore().GetTransaction(ctx)
+	require.NoError(t, db.Create(&Allocation{ID: journalAllocationID,
+		Tx: journalAllocationID, AllocationRoot: allocationRoot}).Error)
+	require.NoError(t, db.Create(&AllocationChangeCollector{ConnectionID: "conn",
+		AllocationID: journalAllocationID, Status: InProgressConnection}).Error)
+	require.NoError(t, db.Commit().Error)
+
+	fileData = &filestore.FileInputData{Name: "file.txt", Path: "/file.txt"}
+	out, err := filestore.GetFileStore().WriteFile(journalAllocationID, fileData,
+		filestoretest.NewMemFile([]byte("content")), "conn")
+	require.NoError(t, err)
+	fileData.Hash = out.ContentHash
+	return
+}
+
+func teardownJournal(dir string) {
+	journalDir = ""
+	os.RemoveAll(dir)
+	datastore.GetStore().Close()
+}
+
+func journalEntries(t *testing.T, dir string) []string {
+	names, err := filepath.Glob(filepath.Join(dir, "*"+journalEntryExt))
+	require.NoError(t, err)
+	return names
+}
+
+func objectStored(hash string) bool {
+	return objectExists(journalAllocationID, &filestore.FileInputData{Hash: hash})
+}
+
+func TestCommitToFileStore_Journal(t *testing.T) {
+	dir, fileData := setupJournal(t, "")
+	defer teardownJournal(dir)
+
+	// an object not referred to anymore, deleted by the commit
+	deleted := &filestore.FileInputData{Name: "old.txt", Path: "/old.txt"}
+	out, err := filestore.GetFileStore().WriteFile(journalAllocationID, deleted,
+		filestoretest.NewMemFile([]byte("old content")), "old")
+	require.NoError(t, err)
+	deleted.Hash = out.ContentHash
+	_, err = filestore.GetFileStore().CommitWrite(journalAllocationID, deleted, "old")
+	require.NoError(t, err)
+
+	cc := &AllocationChangeCollector{ConnectionID: "conn",
+		AllocationID: journalAllocationID}
+	cc.AllocationChanges = []AllocationChangeProcessor{
+		&NewFileChange{ConnectionID: "conn", AllocationID: journalAllocationID,
+			Filename: fileData.Name, Path: fileData.Path, Hash: fileData.Hash},
+		&DeleteFileChange{ConnectionID: "conn", AllocationID: journalAllocationID,
+			ContentHash: map[string]bool{deleted.Hash: true}},
+	}
+
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	require.NoError(t, cc.CommitToFileStore(ctx, "root"))
+	assert.Len(t, journalEntries(t, dir), 1)
+	assert.True(t, objectStored(fileData.Hash))
+	assert.True(t, objectStored(deleted.Hash))
+
+	require.NoError(t, datastore.GetStore().Commit(ctx))
+	assert.Empty(t, journalEntries(t, dir))
+	assert.False(t, objectStored(deleted.Hash))
+}
+
+func TestRecoverCommits_Replay(t *testing.T) {
+	dir, fileData := setupJournal(t, "root")
+	defer teardownJournal(dir)
+
+	entry := &CommitJournalEntry{AllocationID: journalAllocationID,
+		ConnectionID: "conn", AllocationRoot: "root",
+		Writes: []*filestore.FileInputData{fileData}}
+	require.NoError(t, entry.write())
+
+	require.NoError(t, RecoverCommits(context.Background()))
+	assert.Empty(t, journalEntries(t, dir))
+	assert.True(t, objectStored(fileData.Hash))
+
+	// the files moved before the stop are replayed as well
+	require.NoError(t, entry.write())
+	require.NoError(t, RecoverCommits(context.Background()))
+	assert.Empty(t, journalEntries(t, dir))
+}
+
+func TestRecoverCommits_Rollback(t *testing.T) {
+	dir, fileData := setupJournal(t, "previous_root")
+	defer teardownJournal(dir)
+
+	_, err := filestore.GetFileStore().CommitWrite(journalAllocationID, fileData, "conn")
+	require.NoError(t, err)
+	entry := &CommitJournalEntry{AllocationID: journalAllocationID,
+		ConnectionID: "conn", AllocationRoot: "root",
+		Writes: []*filestore.FileInputData{fileData}}
+	require.NoError(t, entry.write())
+
+	require.NoError(t, RecoverCommits(context.Background()))
+	assert.Empty(t, journalEntries(t, dir))
+	assert.False(t, objectStored(fileData.Hash))
+
+	cc := new(AllocationChangeCollector)
+	require.NoError(t, datastore.GetStore().GetDB().
+		Where("connection_id = ?", "conn").First(cc).Error)
+	assert.Equal(t, DeletedConnection, cc.Status)
+}
@@ -0,0 +1,112 @@
+package allocation
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"0chain.net/blobbercore/reference"
+	"0chain.net/blobbercore/stats"
+	"0chain.net/core/common"
+)
+
+// MoveFileChange moves a file, or a directory with all its content, to
+// another directory. The missing directories of the destination are created.
+type MoveFileChange struct {
+	ConnectionID string `json:"connection_id"`
+	AllocationID string `json:"allocation_id"`
+	SrcPath      string `json:"path"`
+	DestPath     string `json:"dest_path"`
+}
+
+func (mf *MoveFileChange) DeleteTempFile() error {
+	return OperationNotApplicable
+}
+
+func (mf *MoveFileChange) ProcessChange(ctx context.Context, change *AllocationChange, allocationRoot string) (*reference.Ref, error) {
+	srcPath, destPath := filepath.Clean(mf.SrcPath), filepath.Clean(mf.DestPath)
+	if srcPath == "/" {
+		return nil, common.NewError("invalid_parameters", "Cannot move the root directory")
+	}
+	if destPath == srcPath || strings.HasPrefix(destPath, srcPath+"/") {
+		return nil, common.NewError("invalid_parameters", "Cannot move a directory into itself")
+	}
+
+	affectedRef, err := reference.GetObjectTree(ctx, mf.AllocationID, srcPath)
+	if err != nil {
+		return nil, err
+	}
+	newPath := filepath.Join(destPath, affectedRef.Name)
+
+	rootRef, err := reference.GetReferencePathFromPaths(ctx, mf.AllocationID, []string{srcPath, newPath})
+	if err != nil {
+		return nil, err
+	}
+
+	srcDirRef, err := getOrCreateDir(rootRef, filepath.Dir(srcPath))
+	if err != nil {
+		return nil, err
+	}
+	idx := -1
+	for i, child := range srcDirRef.Children {
+		if child.Path == srcPath {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return nil, common.NewError("file_not_found", "Object to move not found in blobber")
+	}
+	srcDirRef.RemoveChild(idx)
+
+	destRef, err := getOrCreateDir(rootRef, destPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range destRef.Children {
+		if child.Path == newPath {
+			return nil, common.NewError("invalid_parameters", "Object already exists at path "+newPath)
+		}
+	}
+
+	affectedRef.UpdatePath(newPath, destRef.Path)
+	if affectedRef.Type == reference.FILE {
+		stats.FileUpdated(ctx, affectedRef.ID)
+	}
+	mf.processChildren(ctx, affectedRef)
+	destRef.AddChild(affectedRef)
+
+	_, err = rootRef.CalculateHash(ctx, true)
+	return rootRef, err
+}
+
+func (mf *MoveFileChange) processChildren(ctx context.Context, curRef *reference.Ref) {
+	for _, childRef := range curRef.Children {
+		newPath := filepath.Join(curRef.Path, childRef.Name)
+		childRef.UpdatePath(newPath, curRef.Path)
+		if childRef.Type == reference.FILE {
+			stats.FileUpdated(ctx, childRef.ID)
+		}
+		if childRef.Type == reference.DIRECTORY {
+			mf.processChildren(ctx, childRef)
+		}
+	}
+}
+
+func (mf *MoveFileChange) Marshal() (string, error) {
+	ret, err := json.Marshal(mf)
+	if err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+func (mf *MoveFileChange) Unmarshal(input string) error {
+	err := json.Unmarshal([]byte(input), mf)
+	return err
+}
+
+func (mf *MoveFileChange) CommitToFileStore(ctx context.Context) error {
+	return nil
+}
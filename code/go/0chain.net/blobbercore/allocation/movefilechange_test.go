@@ -0,0 +1,62 @@
+package allocation
+
+import (
+	"testing"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/reference"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveFileChange_ProcessChange(t *testing.T) {
+	ctx := storeTree(t, "/dir/sub/f1", "/dir/f2", "/other/f3")
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	before, err := reference.GetReference(ctx, treeAllocationID, "/dir/sub/f1")
+	require.NoError(t, err)
+
+	mf := &MoveFileChange{AllocationID: treeAllocationID, SrcPath: "/dir",
+		DestPath: "/other/new"}
+	rootRef, err := mf.ProcessChange(ctx, nil, "")
+	require.NoError(t, err)
+	requireTreeHash(t, ctx, rootRef)
+
+	_, err = reference.GetReference(ctx, treeAllocationID, "/dir")
+	assert.Error(t, err)
+	moved, err := reference.GetReference(ctx, treeAllocationID, "/other/new/dir/sub/f1")
+	require.NoError(t, err)
+	assert.Equal(t, before.ID, moved.ID)
+	assert.Equal(t, "/other/new/dir/sub", moved.ParentPath)
+	assert.Equal(t, before.ContentHash, moved.ContentHash)
+
+	mf = &MoveFileChange{AllocationID: treeAllocationID,
+		SrcPath: "/other/new/dir/f2", DestPath: "/"}
+	rootRef, err = mf.ProcessChange(ctx, nil, "")
+	require.NoError(t, err)
+	requireTreeHash(t, ctx, rootRef)
+	_, err = reference.GetReference(ctx, treeAllocationID, "/f2")
+	assert.NoError(t, err)
+}
+
+func TestMoveFileChange_Rejected(t *testing.T) {
+	ctx := storeTree(t, "/dir/sub/f1", "/other/sub/f2", "/file")
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	tests := []struct{ src, dest string }{
+		{"/", "/other"},
+		{"/dir", "/dir/sub"},
+		{"/dir/sub", "/other"},
+		{"/dir/sub", "/file"},
+		{"/missing", "/other"},
+	}
+	for _, tt := range tests {
+		mf := &MoveFileChange{AllocationID: treeAllocationID,
+			SrcPath: tt.src, DestPath: tt.dest}
+		_, err := mf.ProcessChange(ctx, nil, "")
+		assert.Error(t, err, tt.src+" to "+tt.dest)
+	}
+}
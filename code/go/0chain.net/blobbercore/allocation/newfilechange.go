@@ -158,6 +158,7 @@ func (nfch *NewFileChange) CommitToFileStore(ctx context.Context) error {
 	fileInputData.Name = nfch.Filename
 	fileInputData.Path = nfch.Path
 	fileInputData.Hash = nfch.Hash
+	fileInputData.MimeType = nfch.MimeType
 	_, err := filestore.GetFileStore().CommitWrite(nfch.AllocationID, fileInputData, nfch.ConnectionID)
 	if err != nil {
 		return common.NewError("file_store_error", "Error committing to file store. "+err.Error())
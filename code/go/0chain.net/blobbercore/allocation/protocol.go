@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
+	"0chain.net/blobbercore/config"
 	"0chain.net/blobbercore/datastore"
 	"0chain.net/core/chain"
 	"0chain.net/core/common"
@@ -107,6 +109,8 @@ func VerifyAllocationTransaction(ctx context.Context, allocationTx string,
 				a.BlobberSize = (sa.Size + int64(len(sa.Blobbers)-1)) /
 					int64(len(sa.Blobbers))
 				a.BlobberSizeUsed = 0
+				a.MaxFileVersions = config.Configuration.MaxFileVersions
+				a.FileVersionRetention = int64(config.Configuration.FileVersionRetention / time.Second)
 				break
 			}
 		}
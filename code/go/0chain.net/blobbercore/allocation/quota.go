@@ -0,0 +1,187 @@
+package allocation
+
+import (
+	"context"
+	"io"
+	"mime/multipart"
+	"sync"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+)
+
+var MaxAllocationSizeReached = common.NewError("max_allocation_size",
+	"Max size reached for the allocation with this blobber")
+
+// streamingQuota holds the bytes of the uploads being streamed in, per
+// allocation. They are not in the size of any connection yet.
+var streamingQuota = struct {
+	sync.Mutex
+	reserved map[string]int64
+}{reserved: make(map[string]int64)}
+
+// PendingSize returns the size of the changes of the open connections of the
+// allocation, which are not committed yet.
+func PendingSize(ctx context.Context, allocationID string) (size int64,
+	err error) {
+
+	db := datastore.GetStore().GetTransaction(ctx)
+	err = db.Model(&AllocationChangeCollector{}).
+		Select("COALESCE(SUM(size), 0)").
+		Where("allocation_id = ? AND status IN (?,?)", allocationID,
+			NewConnection, InProgressConnection).
+		Row().Scan(&size)
+	return
+}
+
+// QuotaReservation reserves the bytes of an upload against the size of the
+// allocation on this blobber, as they stream in. The quota is shared by the
+// committed files, the changes of all the open connections and the uploads
+// being streamed.
+type QuotaReservation struct {
+	allocationID string
+	size         int64 // size of the allocation on this blobber
+	used         int64 // committed and pending size
+	credit       int64 // size released by the upload, as of an updated file
+	reserved     int64
+	exceeded     bool
+}
+
+// NewQuotaReservation starts the reservation of an upload to the allocation.
+// The caller releases it once the upload is committed to its connection, or
+// has failed, with ReleaseWithTransaction.
+func NewQuotaReservation(ctx context.Context, a *Allocation) (
+	*QuotaReservation, error) {
+
+	pending, err := PendingSize(ctx, a.ID)
+	if err != nil {
+		return nil, common.NewError("meta_error",
+			"Error reading the pending size of the allocation: "+err.Error())
+	}
+	return &QuotaReservation{
+		allocationID: a.ID,
+		size:         a.BlobberSize,
+		used:         a.BlobberSizeUsed + pending,
+	}, nil
+}
+
+// SetCredit sets the size the upload frees, the one of the file it replaces.
+func (qr *QuotaReservation) SetCredit(credit int64) {
+	streamingQuota.Lock()
+	defer streamingQuota.Unlock()
+	qr.credit = credit
+}
+
+// Available returns the bytes the upload can still stream in.
+func (qr *QuotaReservation) Available() int64 {
+	streamingQuota.Lock()
+	defer streamingQuota.Unlock()
+	return qr.available()
+}
+
+func (qr *QuotaReservation) available() int64 {
+	streaming := streamingQuota.reserved[qr.allocationID] - qr.reserved
+	return qr.size + qr.credit - qr.used - streaming - qr.reserved
+}
+
+// Check fails if the upload of the given total size does not fit.
+func (qr *QuotaReservation) Check(size int64) error {
+	streamingQuota.Lock()
+	defer streamingQuota.Unlock()
+	if size > qr.available()+qr.reserved {
+		return MaxAllocationSizeReached
+	}
+	return nil
+}
+
+// Reserve reserves n more bytes of the upload.
+func (qr *QuotaReservation) Reserve(n int64) error {
+	streamingQuota.Lock()
+	defer streamingQuota.Unlock()
+	if n > qr.available() {
+		qr.exceeded = true
+		return MaxAllocationSizeReached
+	}
+	qr.reserved += n
+	streamingQuota.reserved[qr.allocationID] += n
+	return nil
+}
+
+// LimitBody returns the body of an upload request, failing with
+// MaxAllocationSizeReached once more than the bytes available and the
+// allowance are received. The form is parsed before its file streams through
+// Reader, an upload which can't fit is so refused as it is received instead
+// of once spooled.
+func (qr *QuotaReservation) LimitBody(body io.ReadCloser, allowance int64) io.ReadCloser {
+	return &quotaBody{ReadCloser: body, quota: qr,
+		remaining: qr.Available() + allowance}
+}
+
+type quotaBody struct {
+	io.ReadCloser
+	quota     *QuotaReservation
+	remaining int64
+}
+
+func (qb *quotaBody) Read(p []byte) (n int, err error) {
+	n, err = qb.ReadCloser.Read(p)
+	qb.remaining -= int64(n)
+	if qb.remaining < 0 {
+		streamingQuota.Lock()
+		qb.quota.exceeded = true
+		streamingQuota.Unlock()
+		return 0, MaxAllocationSizeReached
+	}
+	return
+}
+
+// Exceeded tells whether a reservation has failed.
+func (qr *QuotaReservation) Exceeded() bool {
+	streamingQuota.Lock()
+	defer streamingQuota.Unlock()
+	return qr.exceeded
+}
+
+// Release gives the reserved bytes back.
+func (qr *QuotaReservation) Release() {
+	streamingQuota.Lock()
+	defer streamingQuota.Unlock()
+	if qr.reserved == 0 {
+		return
+	}
+	streamingQuota.reserved[qr.allocationID] -= qr.reserved
+	if streamingQuota.reserved[qr.allocationID] <= 0 {
+		delete(streamingQuota.reserved, qr.allocationID)
+	}
+	qr.reserved = 0
+}
+
+// ReleaseWithTransaction releases the reservation once the transaction of the
+// context is committed or rolled back. Until the size of the upload is
+// committed to its connection, the bytes reserved are the only ones other
+// uploads see.
+func (qr *QuotaReservation) ReleaseWithTransaction(ctx context.Context) {
+	datastore.GetStore().OnCommit(ctx, qr.Release)
+	datastore.GetStore().OnRollback(ctx, qr.Release)
+}
+
+// Reader returns the file reserving the bytes read from it, which fails with
+// MaxAllocationSizeReached once the quota is exhausted.
+func (qr *QuotaReservation) Reader(f multipart.File) multipart.File {
+	return &quotaFile{File: f, quota: qr}
+}
+
+type quotaFile struct {
+	multipart.File
+	quota *QuotaReservation
+}
+
+func (qf *quotaFile) Read(p []byte) (n int, err error) {
+	n, err = qf.File.Read(p)
+	if n > 0 {
+		if rerr := qf.quota.Reserve(int64(n)); rerr != nil {
+			return 0, rerr
+		}
+	}
+	return
+}
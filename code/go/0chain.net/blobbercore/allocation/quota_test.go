@@ -0,0 +1,171 @@
+package allocation
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/internal/filestoretest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openQuotaStore(t *testing.T) context.Context {
+	datastore.OpenTheSQLiteStore(t)
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	db := datastore.GetStore().GetTransaction(ctx)
+
+	require.NoError(t, db.Create(&Allocation{ID: "alloc", Tx: "alloc",
+		BlobberSize: 100, BlobberSizeUsed: 40}).Error)
+	for _, cc := range []*AllocationChangeCollector{
+		{ConnectionID: "open", AllocationID: "alloc", Size: 20, Status: InProgressConnection},
+		{ConnectionID: "committed", AllocationID: "alloc", Size: 30, Status: CommittedConnection},
+		{ConnectionID: "deleted", AllocationID: "alloc", Size: 30, Status: DeletedConnection},
+		{ConnectionID: "other", AllocationID: "other", Size: 30, Status: InProgressConnection},
+	} {
+		require.NoError(t, db.Create(cc).Error)
+	}
+	return ctx
+}
+
+func TestQuotaReservation(t *testing.T) {
+	ctx := openQuotaStore(t)
+	defer datastore.GetStore().Close()
+	a := &Allocation{ID: "alloc", BlobberSize: 100, BlobberSizeUsed: 40}
+
+	pending, err := PendingSize(ctx, a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), pending)
+
+	first, err := NewQuotaReservation(ctx, a)
+	require.NoError(t, err)
+	defer first.Release()
+	assert.Equal(t, int64(40), first.Available())
+	require.NoError(t, first.Reserve(30))
+
+	// the bytes streamed by the first upload count for the second one
+	second, err := NewQuotaReservation(ctx, a)
+	require.NoError(t, err)
+	defer second.Release()
+	assert.Equal(t, int64(10), second.Available())
+	assert.Equal(t, MaxAllocationSizeReached, second.Reserve(11))
+	assert.True(t, second.Exceeded())
+
+	// an update frees the size of the file it replaces
+	second.SetCredit(5)
+	require.NoError(t, second.Reserve(15))
+	assert.NoError(t, second.Check(15))
+	assert.Error(t, second.Check(16))
+
+	first.Release()
+	assert.Equal(t, int64(30), second.Available())
+}
+
+func TestQuotaReservation_ConcurrentUploads(t *testing.T) {
+	ctx := openQuotaStore(t)
+	defer datastore.GetStore().Close()
+	a := &Allocation{ID: "alloc", BlobberSize: 100, BlobberSizeUsed: 40}
+
+	// both uploads start with 40 bytes available
+	first, err := NewQuotaReservation(ctx, a)
+	require.NoError(t, err)
+	second, err := NewQuotaReservation(ctx, a)
+	require.NoError(t, err)
+	defer second.Release()
+
+	// the first one is saved to its connection, not committed yet
+	first.ReleaseWithTransaction(ctx)
+	require.NoError(t, first.Reserve(30))
+	require.NoError(t, datastore.GetStore().GetTransaction(ctx).Create(
+		&AllocationChangeCollector{ConnectionID: "upload", AllocationID: "alloc",
+			Size: 30, Status: InProgressConnection}).Error)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.Equal(t, MaxAllocationSizeReached, second.Reserve(11))
+		assert.NoError(t, second.Reserve(10))
+	}()
+	wg.Wait()
+
+	// once committed, the size is in the one of the connection
+	require.NoError(t, datastore.GetStore().Commit(ctx))
+	ctx = datastore.GetStore().CreateTransaction(context.Background())
+	defer datastore.GetStore().Rollback(ctx)
+	third, err := NewQuotaReservation(ctx, a)
+	require.NoError(t, err)
+	defer third.Release()
+	assert.Equal(t, int64(0), third.Available())
+}
+
+func TestQuotaReservation_ReleaseOnRollback(t *testing.T) {
+	ctx := openQuotaStore(t)
+	defer datastore.GetStore().Close()
+	a := &Allocation{ID: "alloc", BlobberSize: 100, BlobberSizeUsed: 40}
+
+	qr, err := NewQuotaReservation(ctx, a)
+	require.NoError(t, err)
+	qr.ReleaseWithTransaction(ctx)
+	require.NoError(t, qr.Reserve(30))
+	require.NoError(t, datastore.GetStore().Rollback(ctx))
+
+	ctx = datastore.GetStore().CreateTransaction(context.Background())
+	defer datastore.GetStore().Rollback(ctx)
+	// the open connections are rolled back as well
+	qr, err = NewQuotaReservation(ctx, a)
+	require.NoError(t, err)
+	defer qr.Release()
+	assert.Equal(t, int64(60), qr.Available())
+}
+
+func TestQuotaReservation_Reader(t *testing.T) {
+	ctx := openQuotaStore(t)
+	defer datastore.GetStore().Close()
+	a := &Allocation{ID: "alloc", BlobberSize: 100, BlobberSizeUsed: 40}
+
+	qr, err := NewQuotaReservation(ctx, a)
+	require.NoError(t, err)
+	defer qr.Release()
+
+	_, err = ioutil.ReadAll(qr.Reader(filestoretest.NewMemFile(make([]byte, 41))))
+	assert.Equal(t, MaxAllocationSizeReached, err)
+	assert.True(t, qr.Exceeded())
+
+	qr.Release()
+	qr, err = NewQuotaReservation(ctx, a)
+	require.NoError(t, err)
+	defer qr.Release()
+	data, err := ioutil.ReadAll(qr.Reader(filestoretest.NewMemFile(make([]byte, 40))))
+	require.NoError(t, err)
+	assert.Len(t, data, 40)
+	assert.Equal(t, int64(0), qr.Available())
+}
+
+func TestQuotaReservation_LimitBody(t *testing.T) {
+	ctx := openQuotaStore(t)
+	defer datastore.GetStore().Close()
+	a := &Allocation{ID: "alloc", BlobberSize: 100, BlobberSizeUsed: 40}
+
+	qr, err := NewQuotaReservation(ctx, a)
+	require.NoError(t, err)
+	defer qr.Release()
+
+	// the 40 bytes available and the allowance of 10
+	body := qr.LimitBody(ioutil.NopCloser(bytes.NewReader(make([]byte, 50))), 10)
+	data, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+	assert.Len(t, data, 50)
+	assert.False(t, qr.Exceeded())
+	// nothing is reserved, the file is through Reader
+	assert.Equal(t, int64(40), qr.Available())
+
+	body = qr.LimitBody(ioutil.NopCloser(bytes.NewReader(make([]byte, 51))), 10)
+	_, err = ioutil.ReadAll(body)
+	assert.Equal(t, MaxAllocationSizeReached, err)
+	assert.True(t, qr.Exceeded())
+}
@@ -0,0 +1,84 @@
+package allocation
+
+import (
+	"context"
+	"encoding/json"
+
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/blobbercore/stats"
+	"0chain.net/core/common"
+
+	"gorm.io/gorm"
+)
+
+// RestoreFileChange brings a file of the trash back to its path, creating
+// its missing parent directories.
+type RestoreFileChange struct {
+	ConnectionID string `json:"connection_id"`
+	AllocationID string `json:"allocation_id"`
+	RefID        int64  `json:"ref_id"`
+	Path         string `json:"path"`
+	Size         int64  `json:"size"`
+}
+
+func (rf *RestoreFileChange) DeleteTempFile() error {
+	return OperationNotApplicable
+}
+
+func (rf *RestoreFileChange) ProcessChange(ctx context.Context, change *AllocationChange, allocationRoot string) (*reference.Ref, error) {
+	if !TrashEnabled() {
+		return nil, common.NewError("trash_disabled", "The trash is disabled on the blobber")
+	}
+	ref, err := reference.GetTrashedRef(ctx, rf.AllocationID, rf.RefID, TrashExpiry())
+	if err != nil {
+		return nil, common.NewError("file_not_found", "File to restore not found in the trash")
+	}
+	if !objectExists(rf.AllocationID, &filestore.FileInputData{Hash: ref.ContentHash}) {
+		return nil, common.NewError("file_not_found", "Content of the file to restore not found in blobber")
+	}
+
+	rootRef, err := reference.GetReferencePath(ctx, rf.AllocationID, ref.Path)
+	if err != nil {
+		return nil, err
+	}
+	dirRef, err := getOrCreateDir(rootRef, ref.ParentPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range dirRef.Children {
+		if child.Path == ref.Path {
+			return nil, common.NewError("invalid_parameters", "Object already exists at path "+ref.Path)
+		}
+	}
+
+	if err = reference.UndeleteReference(ctx, ref.ID); err != nil {
+		return nil, err
+	}
+	ref.DeletedAt = gorm.DeletedAt{}
+	ref.WriteMarker = allocationRoot
+	dirRef.AddChild(ref)
+
+	if _, err = rootRef.CalculateHash(ctx, true); err != nil {
+		return nil, err
+	}
+	stats.FileUpdated(ctx, ref.ID)
+	return rootRef, nil
+}
+
+func (rf *RestoreFileChange) Marshal() (string, error) {
+	ret, err := json.Marshal(rf)
+	if err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+func (rf *RestoreFileChange) Unmarshal(input string) error {
+	err := json.Unmarshal([]byte(input), rf)
+	return err
+}
+
+func (rf *RestoreFileChange) CommitToFileStore(ctx context.Context) error {
+	return nil
+}
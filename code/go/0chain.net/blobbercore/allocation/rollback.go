@@ -0,0 +1,64 @@
+package allocation
+
+import (
+	"context"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/common"
+
+	"gorm.io/gorm"
+)
+
+// Rollback restores the references of the allocation to its snapshot as of
+// the allocation root, and reconciles the sizes used with the restored tree.
+// The snapshots taken after are dropped, and once the transaction commits
+// the objects no longer referred to are deleted.
+func Rollback(ctx context.Context, a *Allocation, allocationRoot string) error {
+	snapshot, err := reference.GetSnapshot(ctx, a.ID, allocationRoot)
+	if err != nil {
+		return err
+	}
+	replaced, err := reference.RestoreSnapshot(ctx, a.ID, snapshot)
+	if err != nil {
+		return common.NewError("rollback_error", err.Error())
+	}
+
+	// the versions kept are not rolled back
+	sizeUsed, err := reference.FileVersionsSize(ctx, a.ID)
+	if err != nil {
+		return common.NewError("rollback_error", err.Error())
+	}
+	rootRef, err := reference.GetReference(ctx, a.ID, "/")
+	if err == nil {
+		sizeUsed += rootRef.Size
+	} else if err != gorm.ErrRecordNotFound {
+		return common.NewError("rollback_error", err.Error())
+	}
+
+	db := datastore.GetStore().GetTransaction(ctx)
+	err = db.Model(a).Updates(map[string]interface{}{
+		"allocation_root":   allocationRoot,
+		"blobber_size_used": sizeUsed,
+		"used_size":         gorm.Expr("used_size + ?", sizeUsed-a.BlobberSizeUsed),
+	}).Error
+	if err != nil {
+		return common.NewError("rollback_error", err.Error())
+	}
+	if err = reference.DeleteSnapshotsAfter(ctx, a.ID, allocationRoot); err != nil {
+		return common.NewError("rollback_error", err.Error())
+	}
+
+	var objects []string
+	seen := make(map[string]bool)
+	for _, ref := range replaced {
+		for _, hash := range []string{ref.ContentHash, ref.ThumbnailHash} {
+			if hash != "" && !seen[hash] {
+				seen[hash] = true
+				objects = append(objects, hash)
+			}
+		}
+	}
+	deleteObjectsOnCommit(ctx, a.ID, objects)
+	return nil
+}
@@ -0,0 +1,99 @@
+package allocation
+
+import (
+	"testing"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/internal/filestoretest"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/encryption"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollback(t *testing.T) {
+	ctx := storeTree(t, "/a/file.txt")
+	defer datastore.GetStore().Close()
+	_, err := filestore.SetupStore(filestore.MemoryStoreBackend, "")
+	require.NoError(t, err)
+	db := datastore.GetStore().GetTransaction(ctx)
+
+	a := &Allocation{ID: treeAllocationID, Tx: treeAllocationID,
+		AllocationRoot: "root1", BlobberSizeUsed: 10, UsedSize: 10}
+	require.NoError(t, db.Create(a).Error)
+	require.NoError(t, reference.SaveSnapshot(ctx, a.ID, "root1", 2))
+	tree, err := reference.GetObjectTree(ctx, a.ID, "/")
+	require.NoError(t, err)
+	rootHash := tree.Hash
+
+	// the commit of root2 moves the file and uploads another one
+	mf := &MoveFileChange{AllocationID: a.ID, SrcPath: "/a/file.txt", DestPath: "/b"}
+	_, err = mf.ProcessChange(ctx, nil, "")
+	require.NoError(t, err)
+	fileData := &filestore.FileInputData{Name: "new.txt", Path: "/b/new.txt"}
+	out, err := filestore.GetFileStore().WriteFile(a.ID, fileData,
+		filestoretest.NewMemFile([]byte("content")), "conn")
+	require.NoError(t, err)
+	fileData.Hash = out.ContentHash
+	_, err = filestore.GetFileStore().CommitWrite(a.ID, fileData, "conn")
+	require.NoError(t, err)
+	newRef := reference.NewFileRef()
+	newRef.AllocationID, newRef.Name, newRef.Path, newRef.ParentPath = a.ID, "new.txt", "/b/new.txt", "/b"
+	newRef.LookupHash = reference.GetReferenceLookup(a.ID, newRef.Path)
+	newRef.PathLevel, newRef.Size, newRef.ContentHash = 3, 5, fileData.Hash
+	require.NoError(t, db.Create(newRef).Error)
+	tree, err = reference.GetObjectTree(ctx, a.ID, "/")
+	require.NoError(t, err)
+	_, err = tree.CalculateHash(ctx, true)
+	require.NoError(t, err)
+	require.NoError(t, db.Model(a).Updates(map[string]interface{}{
+		"allocation_root": "root2", "blobber_size_used": 15, "used_size": 15}).Error)
+	require.NoError(t, reference.SaveSnapshot(ctx, a.ID, "root2", 2))
+
+	require.NoError(t, Rollback(ctx, a, "root1"))
+	requireTreeHash(t, ctx, &reference.Ref{Hash: rootHash})
+	_, err = reference.GetReference(ctx, a.ID, "/a/file.txt")
+	assert.NoError(t, err)
+	for _, path := range []string{"/b", "/b/new.txt", "/b/file.txt"} {
+		_, err = reference.GetReference(ctx, a.ID, path)
+		assert.Error(t, err, path)
+	}
+
+	stored := new(Allocation)
+	require.NoError(t, db.Where("id = ?", a.ID).First(stored).Error)
+	assert.Equal(t, "root1", stored.AllocationRoot)
+	assert.Equal(t, int64(10), stored.BlobberSizeUsed)
+	assert.Equal(t, int64(10), stored.UsedSize)
+	_, err = reference.GetSnapshot(ctx, a.ID, "root2")
+	assert.Error(t, err)
+
+	// the uploaded object goes once the rollback commits
+	assert.True(t, objectExists(a.ID, fileData))
+	require.NoError(t, datastore.GetStore().Commit(ctx))
+	assert.False(t, objectExists(a.ID, fileData))
+}
+
+func TestSaveSnapshot_Prune(t *testing.T) {
+	ctx := storeTree(t, "/file.txt")
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	for _, root := range []string{"root1", "root2", "root3"} {
+		require.NoError(t, reference.SaveSnapshot(ctx, treeAllocationID, root, 2))
+	}
+	_, err := reference.GetSnapshot(ctx, treeAllocationID, "root1")
+	assert.Error(t, err)
+	for _, root := range []string{"root2", "root3"} {
+		refs, err := reference.GetSnapshot(ctx, treeAllocationID, root)
+		require.NoError(t, err)
+		assert.Len(t, refs, 2)
+	}
+
+	// an object of a snapshot is kept
+	inSnapshot, err := reference.IsSnapshotObject(ctx, treeAllocationID,
+		encryption.Hash("/file.txt"))
+	require.NoError(t, err)
+	assert.True(t, inSnapshot)
+}
@@ -0,0 +1,107 @@
+package allocation
+
+import (
+	"context"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/lock"
+	. "0chain.net/core/logging"
+
+	"go.uber.org/zap"
+)
+
+// TrashEnabled tells whether the deleted files are kept in the trash of
+// their allocations.
+func TrashEnabled() bool {
+	return config.Configuration.TrashRetention > 0
+}
+
+// TrashExpiry returns the deletion time before which the files are past the
+// trash retention.
+func TrashExpiry() time.Time {
+	return time.Now().Add(-config.Configuration.TrashRetention)
+}
+
+// IsTrashObject tells whether a file in the trash of the allocation refers
+// to the content or the thumbnail object of the hash.
+func IsTrashObject(ctx context.Context, allocationID, hash string) (bool, error) {
+	if !TrashEnabled() {
+		return false, nil
+	}
+	return reference.IsTrashObject(ctx, allocationID, hash, TrashExpiry())
+}
+
+// StartTrashWorker purges the files past the trash retention at every
+// interval, when the trash is enabled.
+func StartTrashWorker(ctx context.Context, interval time.Duration) {
+	if !TrashEnabled() || interval <= 0 {
+		return
+	}
+	go func() {
+		tk := time.NewTicker(interval)
+		defer tk.Stop()
+		for {
+			select {
+			case <-tk.C:
+				purgeTrash(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func purgeTrash(ctx context.Context) {
+	var allocationIDs []string
+	err := datastore.GetStore().GetDB().Unscoped().Model(&reference.Ref{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", TrashExpiry()).
+		Distinct().Pluck("allocation_id", &allocationIDs).Error
+	if err != nil {
+		Logger.Error("Finding the allocations with trash to purge", zap.Error(err))
+		return
+	}
+	for _, allocationID := range allocationIDs {
+		if err := purgeAllocationTrash(ctx, allocationID); err != nil {
+			Logger.Error("Purging the trash",
+				zap.String("allocation_id", allocationID), zap.Error(err))
+		}
+	}
+}
+
+func purgeAllocationTrash(ctx context.Context, allocationID string) error {
+	mutex := lock.GetMutex(Allocation{}.TableName(), allocationID)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	ctx = datastore.GetStore().CreateTransaction(ctx)
+	purged, err := reference.PurgeTrash(ctx, allocationID, TrashExpiry())
+	if err != nil {
+		datastore.GetStore().GetTransaction(ctx).Rollback()
+		return err
+	}
+	var objects []string
+	for _, ref := range purged {
+		if ref.Type != reference.FILE {
+			continue
+		}
+		objects = append(objects, ref.ContentHash)
+		if ref.ThumbnailHash != "" {
+			objects = append(objects, ref.ThumbnailHash)
+		}
+		// the versions went to the trash with the file
+		versions, err := reference.DeleteFileVersions(ctx, ref.ID)
+		if err == nil {
+			err = accountFileVersions(ctx, allocationID, 0, versions)
+		}
+		if err != nil {
+			datastore.GetStore().GetTransaction(ctx).Rollback()
+			return err
+		}
+		objects = append(objects, versionObjects(versions)...)
+	}
+	deleteObjectsOnCommit(ctx, allocationID, objects)
+	return datastore.GetStore().Commit(ctx)
+}
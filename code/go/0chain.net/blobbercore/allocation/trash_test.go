@@ -0,0 +1,116 @@
+package allocation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/reference"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setTrashRetention(retention time.Duration) func() {
+	prev := config.Configuration.TrashRetention
+	config.Configuration.TrashRetention = retention
+	return func() { config.Configuration.TrashRetention = prev }
+}
+
+func trashFile(t *testing.T, ctx context.Context) *reference.Ref {
+	tree, err := reference.GetObjectTree(ctx, treeAllocationID, "/")
+	require.NoError(t, err)
+	_, err = tree.CalculateHash(ctx, true)
+	require.NoError(t, err)
+	ref, err := reference.GetReference(ctx, treeAllocationID, "/file.txt")
+	require.NoError(t, err)
+	df := &DeleteFileChange{AllocationID: treeAllocationID, Path: "/file.txt", Hash: ref.Hash}
+	_, err = df.ProcessChange(ctx, nil, "")
+	require.NoError(t, err)
+	require.NoError(t, df.CommitToFileStore(ctx))
+	return ref
+}
+
+func TestRestoreFileChange(t *testing.T) {
+	defer setTrashRetention(time.Hour)()
+	ctx, fileData := storeVersionedFile(t, 0)
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	ref := trashFile(t, ctx)
+	assert.True(t, objectExists(treeAllocationID, fileData))
+	trash, err := reference.GetTrash(ctx, treeAllocationID, TrashExpiry())
+	require.NoError(t, err)
+	require.Len(t, trash, 1)
+	assert.Equal(t, ref.ID, trash[0].ID)
+
+	rf := &RestoreFileChange{AllocationID: treeAllocationID, RefID: ref.ID}
+	rootRef, err := rf.ProcessChange(ctx, nil, "root")
+	require.NoError(t, err)
+	requireTreeHash(t, ctx, rootRef)
+
+	restored, err := reference.GetReference(ctx, treeAllocationID, "/file.txt")
+	require.NoError(t, err)
+	assert.Equal(t, ref.ID, restored.ID)
+	assert.Equal(t, fileData.Hash, restored.ContentHash)
+	assert.Equal(t, "root", restored.WriteMarker)
+	trash, err = reference.GetTrash(ctx, treeAllocationID, TrashExpiry())
+	require.NoError(t, err)
+	assert.Empty(t, trash)
+
+	// restored once only
+	_, err = rf.ProcessChange(ctx, nil, "root")
+	require.Error(t, err)
+}
+
+func TestRestoreFileChange_PastRetention(t *testing.T) {
+	defer setTrashRetention(time.Hour)()
+	ctx, _ := storeVersionedFile(t, 0)
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	ref := trashFile(t, ctx)
+	require.NoError(t, datastore.GetStore().GetTransaction(ctx).Unscoped().
+		Model(&reference.Ref{}).Where("id = ?", ref.ID).
+		Update("deleted_at", time.Now().Add(-2*time.Hour)).Error)
+
+	rf := &RestoreFileChange{AllocationID: treeAllocationID, RefID: ref.ID}
+	_, err := rf.ProcessChange(ctx, nil, "root")
+	require.Error(t, err)
+}
+
+func TestPurgeTrash(t *testing.T) {
+	defer setTrashRetention(time.Hour)()
+	ctx, fileData := storeVersionedFile(t, 2)
+	defer datastore.GetStore().Close()
+
+	updateFile(t, ctx, fileData.Hash)
+	requireSizeUsed(t, ctx, 20)
+	ref := trashFile(t, ctx)
+	// the versions are kept with the file in the trash
+	versions, err := reference.GetFileVersions(ctx, ref.ID)
+	require.NoError(t, err)
+	assert.Len(t, versions, 1)
+	requireSizeUsed(t, ctx, 20)
+	require.NoError(t, datastore.GetStore().GetTransaction(ctx).Unscoped().
+		Model(&reference.Ref{}).Where("id = ?", ref.ID).
+		Update("deleted_at", time.Now().Add(-2*time.Hour)).Error)
+	require.NoError(t, datastore.GetStore().Commit(ctx))
+	assert.True(t, objectExists(treeAllocationID, fileData))
+
+	require.NoError(t, purgeAllocationTrash(context.Background(), treeAllocationID))
+	assert.False(t, objectExists(treeAllocationID, fileData))
+	var count int64
+	require.NoError(t, datastore.GetStore().GetDB().Unscoped().Model(&reference.Ref{}).
+		Where("id = ?", ref.ID).Count(&count).Error)
+	assert.Zero(t, count)
+
+	ctx = datastore.GetStore().CreateTransaction(context.Background())
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+	versions, err = reference.GetFileVersions(ctx, ref.ID)
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+	requireSizeUsed(t, ctx, 10)
+}
@@ -61,6 +61,9 @@ func (nf *UpdateFileChange) ProcessChange(ctx context.Context, change *Allocatio
 		return nil, common.NewError("file_not_found", "File to update not found in blobber")
 	}
 	existingRef := dirRef.Children[idx]
+	if err = keepFileVersion(ctx, existingRef); err != nil {
+		return nil, common.NewError("file_version_error", err.Error())
+	}
 	existingRef.ActualFileHash = nf.ActualHash
 	existingRef.ActualFileSize = nf.ActualSize
 	existingRef.MimeType = nf.MimeType
@@ -122,6 +125,7 @@ func (nfch *UpdateFileChange) CommitToFileStore(ctx context.Context) error {
 	fileInputData.Name = nfch.Filename
 	fileInputData.Path = nfch.Path
 	fileInputData.Hash = nfch.Hash
+	fileInputData.MimeType = nfch.MimeType
 	_, err := filestore.GetFileStore().CommitWrite(nfch.AllocationID, fileInputData, nfch.ConnectionID)
 	if err != nil {
 		return common.NewError("file_store_error", "Error committing to file store. "+err.Error())
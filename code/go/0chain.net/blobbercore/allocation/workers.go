@@ -10,13 +10,12 @@ import (
 
 	"0chain.net/blobbercore/datastore"
 	"0chain.net/blobbercore/reference"
+	"0chain.net/blobbercore/webhook"
 	"0chain.net/core/chain"
 	"0chain.net/core/common"
 	"0chain.net/core/lock"
 	"0chain.net/core/transaction"
 
-	"gorm.io/gorm"
-
 	. "0chain.net/core/logging"
 	"go.uber.org/zap"
 )
@@ -141,11 +140,11 @@ func shouldFinalize(sa *transaction.StorageAllocation) bool {
 func updateAllocation(ctx context.Context, a *Allocation) {
 
 	if a.Finalized {
-		cleanupAllocation(ctx, a)
+		CleanupAllocation(ctx, a)
 		return
 	}
 
-	var sa, err = requestAllocation(a.ID)
+	var sa, err = RequestAllocation(a.ID)
 	if err != nil {
 		Logger.Error("requesting allocations from SC", zap.Error(err))
 		return
@@ -167,12 +166,13 @@ func updateAllocation(ctx context.Context, a *Allocation) {
 
 	// remove data
 	if a.Finalized && !a.CleanedUp {
-		cleanupAllocation(ctx, a)
+		CleanupAllocation(ctx, a)
 	}
 
 }
 
-func requestAllocation(allocID string) (
+// RequestAllocation fetches the allocation from the storage smart contract.
+func RequestAllocation(allocID string) (
 	sa *transaction.StorageAllocation, err error) {
 
 	var b []byte
@@ -190,12 +190,14 @@ func requestAllocation(allocID string) (
 	return
 }
 
-func commit(tx *gorm.DB, err *error) {
+// commit commits the transaction of the context through the store, for its
+// hooks to run, or rolls it back on error.
+func commit(ctx context.Context, err *error) {
 	if (*err) != nil {
-		tx.Rollback()
+		_ = datastore.GetStore().Rollback(ctx)
 		return
 	}
-	(*err) = tx.Commit().Error
+	(*err) = datastore.GetStore().Commit(ctx)
 }
 
 func updateAllocationInDB(ctx context.Context, a *Allocation,
@@ -204,9 +206,10 @@ func updateAllocationInDB(ctx context.Context, a *Allocation,
 	ctx = datastore.GetStore().CreateTransaction(ctx)
 
 	var tx = datastore.GetStore().GetTransaction(ctx)
-	defer commit(tx, &err)
+	defer commit(ctx, &err)
 
 	var changed bool = a.Tx != sa.Tx
+	var finalized = sa.Finalized && !a.Finalized
 
 	// transaction
 	a.Tx = sa.Tx
@@ -232,6 +235,15 @@ func updateAllocationInDB(ctx context.Context, a *Allocation,
 		return nil, err
 	}
 
+	// not to roll the update back, notifying is logged only
+	if finalized {
+		err := webhook.Notify(ctx, webhook.EventAllocationFinalized,
+			&webhook.AllocationData{AllocationID: a.ID})
+		if err != nil {
+			Logger.Error("notifying allocation 'finalized'", zap.Error(err))
+		}
+	}
+
 	if !changed {
 		return a, nil
 	}
@@ -280,7 +292,9 @@ func sendFinalizeAllocation(a *Allocation) {
 	}
 }
 
-func cleanupAllocation(ctx context.Context, a *Allocation) {
+// CleanupAllocation deletes the files of the allocation and marks it cleaned
+// up.
+func CleanupAllocation(ctx context.Context, a *Allocation) {
 
 	var err error
 	if err = deleteInFakeConnection(ctx, a); err != nil {
@@ -289,11 +303,18 @@ func cleanupAllocation(ctx context.Context, a *Allocation) {
 
 	ctx = datastore.GetStore().CreateTransaction(ctx)
 	var tx = datastore.GetStore().GetTransaction(ctx)
-	defer commit(tx, &err)
+	defer commit(ctx, &err)
 
 	a.CleanedUp = true
 	if err = tx.Model(a).Updates(a).Error; err != nil {
 		Logger.Error("updating allocation 'cleaned_up'", zap.Error(err))
+		return
+	}
+	// not to roll the update back, notifying is logged only
+	notifyErr := webhook.Notify(ctx, webhook.EventAllocationCleanedUp,
+		&webhook.AllocationData{AllocationID: a.ID})
+	if notifyErr != nil {
+		Logger.Error("notifying allocation 'cleaned_up'", zap.Error(notifyErr))
 	}
 }
 
@@ -307,8 +328,7 @@ func newConnectionID() string {
 
 func deleteInFakeConnection(ctx context.Context, a *Allocation) (err error) {
 	ctx = datastore.GetStore().CreateTransaction(ctx)
-	var tx = datastore.GetStore().GetTransaction(ctx)
-	defer commit(tx, &err)
+	defer commit(ctx, &err)
 
 	var (
 		connID = newConnectionID()
@@ -0,0 +1,36 @@
+package allocation
+
+import (
+	"context"
+	"testing"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/transaction"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateAllocationInDB_NotifyFailure(t *testing.T) {
+	datastore.OpenTheSQLiteStore(t)
+	defer datastore.GetStore().Close()
+	prev := config.Configuration.Webhooks
+	defer func() { config.Configuration.Webhooks = prev }()
+	config.Configuration.Webhooks = []config.WebhookConfig{{URL: "http://localhost/hook"}}
+
+	db := datastore.GetStore().GetDB()
+	a := &Allocation{ID: "alloc", Tx: "tx"}
+	require.NoError(t, db.Create(a).Error)
+	// the deliveries can't be queued
+	require.NoError(t, db.Exec("DROP TABLE webhook_deliveries").Error)
+
+	_, err := updateAllocationInDB(context.Background(), a,
+		&transaction.StorageAllocation{ID: "alloc", Tx: "tx", Size: 100, Finalized: true})
+	require.NoError(t, err)
+
+	var updated Allocation
+	require.NoError(t, db.Where("id = ?", "alloc").First(&updated).Error)
+	assert.True(t, updated.Finalized)
+	assert.Equal(t, int64(100), updated.TotalSize)
+}
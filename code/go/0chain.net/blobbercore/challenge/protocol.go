@@ -8,13 +8,14 @@ import (
 
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/metrics"
 	"0chain.net/blobbercore/reference"
+	"0chain.net/blobbercore/webhook"
 	"0chain.net/blobbercore/writemarker"
 	"0chain.net/core/chain"
 	"0chain.net/core/common"
 	. "0chain.net/core/logging"
 	"0chain.net/core/transaction"
-	"0chain.net/core/util"
 
 	"go.uber.org/zap"
 )
@@ -90,21 +91,16 @@ func (cr *ChallengeEntity) GetValidationTickets(ctx context.Context) error {
 	}
 
 	rootRef, err := reference.GetReference(ctx, cr.AllocationID, "/")
-	blockNum := int64(0)
-	if rootRef.NumBlocks > 0 {
-		r := rand.New(rand.NewSource(cr.RandomNumber))
-		//rand.Seed(cr.RandomNumber)
-		blockNum = r.Int63n(rootRef.NumBlocks)
-		blockNum = blockNum + 1
-	} else {
-		Logger.Error("Got a challenge for a blank allocation")
-	}
-
-	cr.BlockNum = blockNum
 	if err != nil {
 		cr.ErrorChallenge(ctx, err)
 		return err
 	}
+	blockNum := challengedBlockNum(rootRef.NumBlocks, cr.RandomNumber)
+	if blockNum == 0 {
+		Logger.Error("Got a challenge for a blank allocation")
+	}
+
+	cr.BlockNum = blockNum
 	Logger.Info("blockNum for challenge", zap.Any("rootRef.NumBlocks", rootRef.NumBlocks), zap.Any("blockNum", blockNum), zap.Any("challenge_id", cr.ChallengeID), zap.Any("random_seed", cr.RandomNumber))
 	objectPath, err := reference.GetObjectPath(ctx, cr.AllocationID, blockNum)
 	if err != nil {
@@ -115,41 +111,11 @@ func (cr *ChallengeEntity) GetValidationTickets(ctx context.Context) error {
 	cr.RespondedAllocationRoot = allocationObj.AllocationRoot
 	cr.ObjectPath = objectPath
 
-	postData := make(map[string]interface{})
-	postData["challenge_id"] = cr.ChallengeID
-	postData["object_path"] = objectPath
-	markersArray := make([]map[string]interface{}, 0)
-	for _, wm := range wms {
-		markersMap := make(map[string]interface{})
-		markersMap["write_marker"] = wm.WM
-		markersMap["client_key"] = wm.ClientPublicKey
-		markersArray = append(markersArray, markersMap)
-	}
-	postData["write_markers"] = markersArray
-
-	if blockNum > 0 {
-		if objectPath.Meta["type"] != reference.FILE {
-			Logger.Info("Block number to be challenged for file:", zap.Any("block", objectPath.FileBlockNum), zap.Any("meta", objectPath.Meta), zap.Any("obejct_path", objectPath))
-			err = common.NewError("invalid_object_path", "Object path was not for a file")
-			cr.ErrorChallenge(ctx, err)
-			return err
-		}
-
-		inputData := &filestore.FileInputData{}
-		inputData.Name = objectPath.Meta["name"].(string)
-		inputData.Path = objectPath.Meta["path"].(string)
-		inputData.Hash = objectPath.Meta["content_hash"].(string)
-		r := rand.New(rand.NewSource(cr.RandomNumber))
-		//rand.Seed(cr.RandomNumber)
-		blockoffset := r.Intn(1024)
-		blockData, mt, err := filestore.GetFileStore().GetFileBlockForChallenge(cr.AllocationID, inputData, blockoffset)
-
-		if err != nil {
-			cr.ErrorChallenge(ctx, err)
-			return common.NewError("blockdata_not_found", err.Error())
-		}
-		postData["data"] = []byte(blockData)
-		postData["merkle_path"] = mt.GetPathByIndex(blockoffset)
+	postData, err := challengeData(cr.ChallengeID, cr.AllocationID,
+		cr.RandomNumber, blockNum, objectPath, wms)
+	if err != nil {
+		cr.ErrorChallenge(ctx, err)
+		return err
 	}
 
 	postDataBytes, err := json.Marshal(postData)
@@ -158,43 +124,15 @@ func (cr *ChallengeEntity) GetValidationTickets(ctx context.Context) error {
 		cr.ErrorChallenge(ctx, err)
 		return err
 	}
-	responses := make(map[string]ValidationTicket)
 	if cr.ValidationTickets == nil {
 		cr.ValidationTickets = make([]*ValidationTicket, len(cr.Validators))
 	}
-	for i, validator := range cr.Validators {
-		if cr.ValidationTickets[i] != nil {
-			exisitingVT := cr.ValidationTickets[i]
-			if len(exisitingVT.Signature) > 0 && exisitingVT.ChallengeID == cr.ChallengeID {
-				continue
-			}
-		}
-		url := validator.URL + VALIDATOR_URL
-		resp, err := util.SendPostRequest(url, postDataBytes, nil)
-		if err != nil {
-			Logger.Info("Got error from the validator.", zap.Any("error", err.Error()))
-			delete(responses, validator.ID)
-			cr.ValidationTickets[i] = nil
-			continue
-		}
-		var validationTicket ValidationTicket
-		err = json.Unmarshal(resp, &validationTicket)
-		if err != nil {
-			Logger.Info("Got error decoding from the validator response .", zap.Any("resp", string(resp)), zap.Any("error", err.Error()))
-			delete(responses, validator.ID)
-			cr.ValidationTickets[i] = nil
-			continue
-		}
-		Logger.Info("Got response from the validator.", zap.Any("validator_response", validationTicket))
-		verified, err := validationTicket.VerifySign()
-		if err != nil || !verified {
-			Logger.Info("Validation ticket from validator could not be verified.")
-			delete(responses, validator.ID)
-			cr.ValidationTickets[i] = nil
-			continue
+	cr.collectValidationTickets(ctx, postDataBytes)
+	responses := make(map[string]ValidationTicket)
+	for i, vt := range cr.ValidationTickets {
+		if vt != nil {
+			responses[cr.Validators[i].ID] = *vt
 		}
-		responses[validator.ID] = validationTicket
-		cr.ValidationTickets[i] = &validationTicket
 	}
 
 	numSuccess := 0
@@ -216,13 +154,24 @@ func (cr *ChallengeEntity) GetValidationTickets(ctx context.Context) error {
 	if numSuccess > (len(cr.Validators)/2) || numFailure > (len(cr.Validators)/2) || numValidatorsResponded == len(cr.Validators) {
 		if numSuccess > (len(cr.Validators) / 2) {
 			cr.Result = ChallengeSuccess
+			metrics.ChallengeOutcomes.WithLabelValues(metrics.Success).Inc()
 		} else {
 			cr.Result = ChallengeFailure
+			metrics.ChallengeOutcomes.WithLabelValues(metrics.Failure).Inc()
 			Logger.Error("Challenge failed by the validators", zap.Any("block_num", cr.BlockNum), zap.Any("object_path", objectPath), zap.Any("challenge", cr))
+			err := webhook.Notify(ctx, webhook.EventChallengeFailed, &webhook.ChallengeData{
+				ChallengeID:  cr.ChallengeID,
+				AllocationID: cr.AllocationID,
+				Error:        "challenge failed by the validators",
+			})
+			if err != nil {
+				Logger.Error("Notifying the failed challenge", zap.String("challenge_id", cr.ChallengeID), zap.Error(err))
+			}
 		}
 
 		cr.Status = Processed
 	} else {
+		metrics.ChallengeOutcomes.WithLabelValues("no_consensus").Inc()
 		cr.ErrorChallenge(ctx, common.NewError("no_consensus_challenge", "No Consensus on the challenge result. Erroring out the challenge"))
 		return common.NewError("no_consensus_challenge", "No Consensus on the challenge result. Erroring out the challenge")
 	}
@@ -230,6 +179,58 @@ func (cr *ChallengeEntity) GetValidationTickets(ctx context.Context) error {
 	return cr.Save(ctx)
 }
 
+// challengedBlockNum returns the number of the block challenged with the
+// seed among the blocks of an allocation, zero for a blank one.
+func challengedBlockNum(numBlocks, seed int64) int64 {
+	if numBlocks <= 0 {
+		return 0
+	}
+	r := rand.New(rand.NewSource(seed))
+	return r.Int63n(numBlocks) + 1
+}
+
+// challengeData returns the data of the validation request of the challenge
+// of the seed, on the object path of the challenged block, with the write
+// markers from the challenged allocation root.
+func challengeData(challengeID, allocationID string, seed, blockNum int64,
+	objectPath *reference.ObjectPath, wms []*writemarker.WriteMarkerEntity) (
+	map[string]interface{}, error) {
+
+	postData := make(map[string]interface{})
+	postData["challenge_id"] = challengeID
+	postData["object_path"] = objectPath
+	markersArray := make([]map[string]interface{}, 0)
+	for _, wm := range wms {
+		markersMap := make(map[string]interface{})
+		markersMap["write_marker"] = wm.WM
+		markersMap["client_key"] = wm.ClientPublicKey
+		markersArray = append(markersArray, markersMap)
+	}
+	postData["write_markers"] = markersArray
+
+	if blockNum <= 0 {
+		return postData, nil // blank allocation
+	}
+	if objectPath.Meta["type"] != reference.FILE {
+		Logger.Info("Block number to be challenged for file:", zap.Any("block", objectPath.FileBlockNum), zap.Any("meta", objectPath.Meta), zap.Any("obejct_path", objectPath))
+		return nil, common.NewError("invalid_object_path", "Object path was not for a file")
+	}
+
+	inputData := &filestore.FileInputData{}
+	inputData.Name = objectPath.Meta["name"].(string)
+	inputData.Path = objectPath.Meta["path"].(string)
+	inputData.Hash = objectPath.Meta["content_hash"].(string)
+	r := rand.New(rand.NewSource(seed))
+	blockoffset := r.Intn(1024)
+	blockData, mt, err := filestore.GetFileStore().GetFileBlockForChallenge(allocationID, inputData, blockoffset)
+	if err != nil {
+		return nil, common.NewError("blockdata_not_found", err.Error())
+	}
+	postData["data"] = []byte(blockData)
+	postData["merkle_path"] = mt.GetPathByIndex(blockoffset)
+	return postData, nil
+}
+
 func (cr *ChallengeEntity) CommitChallenge(ctx context.Context, verifyOnly bool) error {
 
 	if len(cr.LastCommitTxnIDs) > 0 {
@@ -261,8 +262,10 @@ func (cr *ChallengeEntity) CommitChallenge(ctx context.Context, verifyOnly bool)
 			cr.LastCommitTxnIDs = append(cr.LastCommitTxnIDs, t.Hash)
 		}
 		cr.ErrorChallenge(ctx, err)
+		metrics.ChallengeOutcomes.WithLabelValues("commit_error").Inc()
 		Logger.Error("Error while submitting challenge to BC.", zap.String("challenge_id", cr.ChallengeID), zap.Error(err))
 	} else {
+		metrics.ChallengeOutcomes.WithLabelValues("committed").Inc()
 		cr.Status = Committed
 		cr.StatusMessage = t.TransactionOutput
 		cr.CommitTxnID = t.Hash
@@ -0,0 +1,287 @@
+package challenge
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/metrics"
+	"0chain.net/core/common"
+	. "0chain.net/core/logging"
+
+	"go.uber.org/zap"
+)
+
+// scheduledChallenge is an accepted challenge to validate.
+type scheduledChallenge struct {
+	challengeID string
+	created     time.Time
+	// deadline is the time the challenge expires at, zero if the challenge
+	// completion time is not set.
+	deadline time.Time
+	attempts int
+	// givenUp is set once the challenge is no longer retried.
+	givenUp bool
+	index   int
+}
+
+func (sc *scheduledChallenge) expired(now time.Time) bool {
+	return !sc.deadline.IsZero() && now.After(sc.deadline)
+}
+
+// challengeQueue is a heap of the challenges ready to validate, the one of
+// the earliest deadline first.
+type challengeQueue []*scheduledChallenge
+
+func (q challengeQueue) Len() int { return len(q) }
+
+func (q challengeQueue) Less(i, j int) bool {
+	if q[i].deadline.IsZero() != q[j].deadline.IsZero() {
+		return !q[i].deadline.IsZero()
+	}
+	if !q[i].deadline.Equal(q[j].deadline) {
+		return q[i].deadline.Before(q[j].deadline)
+	}
+	return q[i].created.Before(q[j].created)
+}
+
+func (q challengeQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *challengeQueue) Push(x interface{}) {
+	sc := x.(*scheduledChallenge)
+	sc.index = len(*q)
+	*q = append(*q, sc)
+}
+
+func (q *challengeQueue) Pop() interface{} {
+	old := *q
+	sc := old[len(old)-1]
+	old[len(old)-1] = nil
+	*q = old[:len(old)-1]
+	return sc
+}
+
+// scheduler validates the accepted challenges with a pool of workers, the
+// ones closest to their deadline first, and retries the failed validations
+// with backoff until the challenges expire.
+type scheduler struct {
+	mu    sync.Mutex
+	cond  *sync.Cond
+	ready challengeQueue
+	// scheduled are the challenges ready, waiting for a retry, being
+	// validated or given up, by id.
+	scheduled map[string]*scheduledChallenge
+	// validate validates the challenge of the id, nil once it is processed.
+	validate func(ctx context.Context, challengeID string) error
+}
+
+func newScheduler(validate func(ctx context.Context, challengeID string) error) *scheduler {
+	s := &scheduler{
+		scheduled: make(map[string]*scheduledChallenge),
+		validate:  validate,
+	}
+	s.cond = sync.NewCond(&s.mu)
+	return s
+}
+
+// start starts the workers validating the scheduled challenges.
+func (s *scheduler) start(ctx context.Context, workers int) {
+	if workers <= 0 {
+		workers = 1
+	}
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		s.cond.Broadcast()
+		s.mu.Unlock()
+	}()
+	for i := 0; i < workers; i++ {
+		go func() {
+			for sc := s.next(ctx); sc != nil; sc = s.next(ctx) {
+				s.process(ctx, sc)
+			}
+		}()
+	}
+}
+
+// schedule schedules the challenges not scheduled yet, and forgets the ones
+// given up which are no longer accepted.
+func (s *scheduler) schedule(challenges []*ChallengeEntity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	accepted := make(map[string]bool, len(challenges))
+	for _, challengeObj := range challenges {
+		accepted[challengeObj.ChallengeID] = true
+		if _, ok := s.scheduled[challengeObj.ChallengeID]; ok {
+			continue
+		}
+		sc := &scheduledChallenge{
+			challengeID: challengeObj.ChallengeID,
+			created:     time.Unix(int64(challengeObj.Created), 0),
+		}
+		if challengeObj.Created == 0 {
+			// accepted before the creation times were kept
+			sc.created = time.Now()
+		}
+		if completion := config.Configuration.ChallengeCompletionTime; completion > 0 {
+			sc.deadline = sc.created.Add(completion)
+		}
+		s.scheduled[sc.challengeID] = sc
+		heap.Push(&s.ready, sc)
+		s.cond.Signal()
+	}
+	for challengeID, sc := range s.scheduled {
+		if sc.givenUp && !accepted[challengeID] {
+			delete(s.scheduled, challengeID)
+		}
+	}
+}
+
+// next returns the ready challenge of the earliest deadline, waiting for one
+// if none is ready. It returns nil once the context is done.
+func (s *scheduler) next(ctx context.Context) *scheduledChallenge {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for len(s.ready) == 0 && ctx.Err() == nil {
+		s.cond.Wait()
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	return heap.Pop(&s.ready).(*scheduledChallenge)
+}
+
+func (s *scheduler) push(sc *scheduledChallenge) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	heap.Push(&s.ready, sc)
+	s.cond.Signal()
+}
+
+// finish stops scheduling the challenge. The challenges given up are kept
+// until they are no longer accepted, not to be scheduled again.
+func (s *scheduler) finish(sc *scheduledChallenge, givenUp bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if givenUp {
+		sc.givenUp = true
+		return
+	}
+	delete(s.scheduled, sc.challengeID)
+}
+
+func (s *scheduler) process(ctx context.Context, sc *scheduledChallenge) {
+	now := time.Now()
+	if sc.attempts == 0 {
+		metrics.ChallengeStages.WithLabelValues("wait").Observe(now.Sub(sc.created).Seconds())
+	}
+	if sc.expired(now) {
+		Logger.Error("Challenge expired before being validated",
+			zap.String("challenge_id", sc.challengeID), zap.Int("attempts", sc.attempts))
+		metrics.ChallengeOutcomes.WithLabelValues("expired").Inc()
+		s.finish(sc, true)
+		return
+	}
+
+	sc.attempts++
+	err := s.validate(ctx, sc.challengeID)
+	metrics.ChallengeStages.WithLabelValues("validation").Observe(time.Since(now).Seconds())
+	if err == nil {
+		s.finish(sc, false)
+		wakeUpCommit()
+		return
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	if max := config.Configuration.ChallengeMaxRetires; max > 0 && sc.attempts >= max {
+		Logger.Error("Giving up validating the challenge",
+			zap.String("challenge_id", sc.challengeID), zap.Int("attempts", sc.attempts))
+		s.finish(sc, true)
+		return
+	}
+	delay := retryBackoff(sc, time.Now())
+	Logger.Info("Retrying the challenge validation", zap.String("challenge_id", sc.challengeID),
+		zap.Int("attempts", sc.attempts), zap.Duration("delay", delay))
+	time.AfterFunc(delay, func() { s.push(sc) })
+}
+
+// retryBackoff returns the time to wait for before the next validation of the
+// challenge, doubling with the attempts from the retry backoff up to the max
+// one, and shortened for the challenge to be retried before its deadline.
+func retryBackoff(sc *scheduledChallenge, now time.Time) time.Duration {
+	backoff := config.Configuration.ChallengeRetryBackoff
+	max := config.Configuration.ChallengeMaxRetryBackoff
+	for i := 1; i < sc.attempts && backoff < max; i++ {
+		backoff *= 2
+	}
+	if max > 0 && backoff > max {
+		backoff = max
+	}
+	if !sc.deadline.IsZero() {
+		if remaining := sc.deadline.Sub(now) / 2; remaining < backoff {
+			backoff = remaining
+		}
+	}
+	if backoff < 0 {
+		backoff = 0
+	}
+	return backoff
+}
+
+// scheduleAccepted schedules the accepted challenges which did not expire.
+func (s *scheduler) scheduleAccepted(ctx context.Context) {
+	rctx := datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(rctx)
+	defer db.Rollback()
+
+	query := db.Select("challenge_id, created").Where(ChallengeEntity{Status: Accepted})
+	if completion := config.Configuration.ChallengeCompletionTime; completion > 0 {
+		expiry := common.Now() - common.Timestamp(completion/time.Second)
+		query = query.Where("created = 0 OR created >= ?", expiry)
+	}
+	challenges := make([]*ChallengeEntity, 0)
+	if err := query.Find(&challenges).Error; err != nil {
+		Logger.Error("Error getting the accepted challenges", zap.Error(err))
+		return
+	}
+	s.schedule(challenges)
+}
+
+// validateChallenge gets the validation tickets of the challenge, if it is
+// still accepted.
+func validateChallenge(ctx context.Context, challengeID string) error {
+	ctx = datastore.GetStore().CreateTransaction(ctx)
+	defer ctx.Done()
+	db := datastore.GetStore().GetTransaction(ctx)
+
+	challengeObj, err := GetChallengeEntity(ctx, challengeID)
+	if err != nil {
+		db.Rollback()
+		return err
+	}
+	if challengeObj.Status != Accepted {
+		db.Rollback()
+		return nil
+	}
+	Logger.Info("Processing the challenge", zap.String("challenge_id", challengeID))
+	err = GetValidationTickets(ctx, challengeObj)
+	if err != nil {
+		Logger.Error("Getting validation tickets failed", zap.String("challenge_id", challengeID), zap.Error(err))
+	}
+	if cerr := datastore.GetStore().Commit(ctx); cerr != nil {
+		Logger.Error("Error committing the challenge validation", zap.Error(cerr))
+		if err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
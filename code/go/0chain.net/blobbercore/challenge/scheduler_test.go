@@ -0,0 +1,200 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+	"0chain.net/core/logging"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupScheduling(t *testing.T) func() {
+	logging.Logger = zap.NewNop()
+	prev := config.Configuration
+	config.Configuration.ChallengeCompletionTime = time.Minute
+	config.Configuration.ChallengeMaxRetires = 0
+	config.Configuration.ChallengeRetryBackoff = 10 * time.Millisecond
+	config.Configuration.ChallengeMaxRetryBackoff = 40 * time.Millisecond
+	return func() {
+		config.Configuration = prev
+	}
+}
+
+// validations records the validations of the challenges, failing the ones
+// of the ids until their number of failures.
+type validations struct {
+	mu       sync.Mutex
+	order    []string
+	failures map[string]int
+	done     chan string
+}
+
+func newValidations(failures map[string]int) *validations {
+	return &validations{failures: failures, done: make(chan string, 100)}
+}
+
+func (v *validations) validate(ctx context.Context, challengeID string) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.order = append(v.order, challengeID)
+	if v.failures[challengeID] > 0 {
+		v.failures[challengeID]--
+		return errors.New("no consensus")
+	}
+	v.done <- challengeID
+	return nil
+}
+
+func (v *validations) attempts() []string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return append([]string(nil), v.order...)
+}
+
+func waitDone(t *testing.T, v *validations, n int) []string {
+	var done []string
+	for len(done) < n {
+		select {
+		case id := <-v.done:
+			done = append(done, id)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "challenges not validated", "%v", done)
+		}
+	}
+	return done
+}
+
+func TestScheduler_Order(t *testing.T) {
+	defer setupScheduling(t)()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	v := newValidations(nil)
+	s := newScheduler(v.validate)
+	now := common.Now()
+	s.schedule([]*ChallengeEntity{
+		{ChallengeID: "later", Created: now - 5},
+		{ChallengeID: "urgent", Created: now - 50},
+		{ChallengeID: "soon", Created: now - 30},
+	})
+	s.start(ctx, 1)
+
+	assert.Equal(t, []string{"urgent", "soon", "later"}, waitDone(t, v, 3))
+	s.mu.Lock()
+	assert.Empty(t, s.scheduled)
+	s.mu.Unlock()
+}
+
+func TestScheduler_Retry(t *testing.T) {
+	defer setupScheduling(t)()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	v := newValidations(map[string]int{"flaky": 2})
+	s := newScheduler(v.validate)
+	s.schedule([]*ChallengeEntity{{ChallengeID: "flaky", Created: common.Now()}})
+	s.start(ctx, 2)
+
+	waitDone(t, v, 1)
+	assert.Equal(t, []string{"flaky", "flaky", "flaky"}, v.attempts())
+}
+
+func TestScheduler_GiveUp(t *testing.T) {
+	defer setupScheduling(t)()
+	config.Configuration.ChallengeMaxRetires = 2
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	v := newValidations(map[string]int{"failing": 10})
+	s := newScheduler(v.validate)
+	s.schedule([]*ChallengeEntity{{ChallengeID: "failing", Created: common.Now()}})
+	s.start(ctx, 1)
+
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.scheduled["failing"].givenUp
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Len(t, v.attempts(), 2)
+
+	// not scheduled again while accepted, forgotten once no longer
+	s.schedule([]*ChallengeEntity{{ChallengeID: "failing", Created: common.Now()}})
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, v.attempts(), 2)
+	s.schedule(nil)
+	s.mu.Lock()
+	assert.Empty(t, s.scheduled)
+	s.mu.Unlock()
+}
+
+func TestScheduler_Expired(t *testing.T) {
+	defer setupScheduling(t)()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	v := newValidations(nil)
+	s := newScheduler(v.validate)
+	s.schedule([]*ChallengeEntity{
+		{ChallengeID: "expired", Created: common.Now() - 120},
+		{ChallengeID: "open", Created: common.Now()},
+	})
+	s.start(ctx, 1)
+
+	assert.Equal(t, []string{"open"}, waitDone(t, v, 1))
+	assert.Equal(t, []string{"open"}, v.attempts())
+}
+
+func TestRetryBackoff(t *testing.T) {
+	defer setupScheduling(t)()
+	now := time.Now()
+
+	sc := &scheduledChallenge{attempts: 1}
+	assert.Equal(t, 10*time.Millisecond, retryBackoff(sc, now))
+	sc.attempts = 2
+	assert.Equal(t, 20*time.Millisecond, retryBackoff(sc, now))
+	sc.attempts = 10
+	assert.Equal(t, 40*time.Millisecond, retryBackoff(sc, now))
+
+	// retried before the deadline
+	sc.deadline = now.Add(50 * time.Millisecond)
+	assert.Equal(t, 25*time.Millisecond, retryBackoff(sc, now))
+	sc.deadline = now.Add(-time.Second)
+	assert.Equal(t, time.Duration(0), retryBackoff(sc, now))
+}
+
+func TestScheduleAccepted(t *testing.T) {
+	defer setupScheduling(t)()
+	datastore.OpenTheSQLiteStore(t)
+	defer datastore.GetStore().Close()
+
+	now := common.Now()
+	db := datastore.GetStore().GetDB()
+	for _, challengeObj := range []*ChallengeEntity{
+		{ChallengeID: "accepted", Status: Accepted, Created: now},
+		{ChallengeID: "legacy", Status: Accepted},
+		{ChallengeID: "expired", Status: Accepted, Created: now - 120},
+		{ChallengeID: "processed", Status: Processed, Created: now},
+	} {
+		challengeObj.AllocationID = "allocation"
+		require.NoError(t, db.Create(challengeObj).Error)
+	}
+
+	s := newScheduler(nil)
+	s.scheduleAccepted(context.Background())
+	var ids []string
+	for id := range s.scheduled {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	assert.Equal(t, []string{"accepted", "legacy"}, ids)
+}
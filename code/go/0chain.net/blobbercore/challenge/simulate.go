@@ -0,0 +1,135 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/blobbercore/writemarker"
+	"0chain.net/core/common"
+	validator "0chain.net/validatorcore/storage/models"
+)
+
+// MaxSimulationRounds is the max number of challenges of a simulation.
+const MaxSimulationRounds = 1000
+
+// SimulationResult is the outcome of a simulated challenge.
+type SimulationResult struct {
+	Seed     int64 `json:"seed"`
+	BlockNum int64 `json:"block_num"`
+	// Path and ContentHash are the ones of the challenged file, if any.
+	Path        string `json:"path,omitempty"`
+	ContentHash string `json:"content_hash,omitempty"`
+	Passed      bool   `json:"passed"`
+	// Corrupted tells whether the challenged block of the file could not be
+	// read, or does not match the merkle root of the file.
+	Corrupted bool   `json:"corrupted,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// SimulationReport is the outcome of the challenges simulated on an
+// allocation.
+type SimulationReport struct {
+	AllocationID   string              `json:"allocation_id"`
+	AllocationRoot string              `json:"allocation_root"`
+	Results        []*SimulationResult `json:"results"`
+	// CorruptedFiles are the paths of the files of the corrupted blocks.
+	CorruptedFiles []string `json:"corrupted_files"`
+}
+
+// SimulateChallenges answers challenges of the seeds from seed to
+// seed+rounds-1 on the latest allocation root of the allocation, as done
+// for the challenges of the blockchain, and verifies the answers as the
+// validators do. Nothing is sent to the validators nor to the blockchain.
+func SimulateChallenges(ctx context.Context, allocationID string, seed int64,
+	rounds int) (*SimulationReport, error) {
+
+	if rounds <= 0 || rounds > MaxSimulationRounds {
+		return nil, common.NewError("invalid_parameters",
+			"The rounds must be from 1 to "+strconv.Itoa(MaxSimulationRounds))
+	}
+	allocationObj, err := allocation.GetAllocationByID(ctx, allocationID)
+	if err != nil {
+		return nil, common.NewError("invalid_allocation", err.Error())
+	}
+	wms, err := writemarker.GetWriteMarkersInRange(ctx, allocationID,
+		allocationObj.AllocationRoot, allocationObj.AllocationRoot)
+	if err != nil {
+		return nil, err
+	}
+	rootRef, err := reference.GetReference(ctx, allocationID, "/")
+	if err != nil {
+		return nil, common.NewError("invalid_dir_struct", err.Error())
+	}
+
+	report := &SimulationReport{
+		AllocationID:   allocationID,
+		AllocationRoot: allocationObj.AllocationRoot,
+		Results:        make([]*SimulationResult, 0, rounds),
+		CorruptedFiles: make([]string, 0),
+	}
+	corrupted := make(map[string]bool)
+	for i := 0; i < rounds; i++ {
+		result := simulateChallenge(ctx, allocationObj, rootRef.NumBlocks,
+			seed+int64(i), wms)
+		report.Results = append(report.Results, result)
+		if result.Corrupted && !corrupted[result.Path] {
+			corrupted[result.Path] = true
+			report.CorruptedFiles = append(report.CorruptedFiles, result.Path)
+		}
+	}
+	return report, nil
+}
+
+func simulateChallenge(ctx context.Context, allocationObj *allocation.Allocation,
+	numBlocks, seed int64, wms []*writemarker.WriteMarkerEntity) *SimulationResult {
+
+	result := &SimulationResult{
+		Seed:     seed,
+		BlockNum: challengedBlockNum(numBlocks, seed),
+	}
+	objectPath, err := reference.GetObjectPath(ctx, allocationObj.ID, result.BlockNum)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Path, _ = objectPath.Meta["path"].(string)
+	result.ContentHash, _ = objectPath.Meta["content_hash"].(string)
+
+	challengeID := "simulation_" + strconv.FormatInt(seed, 10)
+	postData, err := challengeData(challengeID, allocationObj.ID, seed,
+		result.BlockNum, objectPath, wms)
+	if err != nil {
+		result.Error = err.Error()
+		cerr, ok := err.(*common.Error)
+		result.Corrupted = ok && cerr.Code == "blockdata_not_found"
+		return result
+	}
+
+	// the validators get the request as JSON
+	postDataBytes, err := json.Marshal(postData)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	var request validator.ChallengeRequest
+	if err = json.Unmarshal(postDataBytes, &request); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	err = request.VerifyChallenge(&validator.Challenge{
+		ID:             challengeID,
+		RandomNumber:   seed,
+		AllocationID:   allocationObj.ID,
+		AllocationRoot: allocationObj.AllocationRoot,
+	}, &validator.Allocation{ID: allocationObj.ID})
+	if err != nil {
+		result.Error = err.Error()
+		result.Corrupted = err == validator.ErrMerklePathMismatch
+		return result
+	}
+	result.Passed = true
+	return result
+}
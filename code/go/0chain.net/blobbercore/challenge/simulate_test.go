@@ -0,0 +1,166 @@
+package challenge
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/internal/filestoretest"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/blobbercore/writemarker"
+	"0chain.net/core/common"
+	"0chain.net/core/config"
+	"0chain.net/core/encryption"
+	"0chain.net/core/logging"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const simulatedAllocationID = "simulated_allocation"
+
+// storeSimulatedAllocation stores an allocation of the files, with its write
+// marker signed by a new owner.
+func storeSimulatedAllocation(t *testing.T, ctx context.Context,
+	files map[string]string) {
+
+	logging.Logger = zap.NewNop()
+	config.Configuration.SignatureScheme = "bls0chain"
+	owner := zcncrypto.NewSignatureScheme("bls0chain")
+	_, err := owner.GenerateKeys()
+	require.NoError(t, err)
+	ownerKey := owner.GetPublicKey()
+	keyBytes, err := hex.DecodeString(ownerKey)
+	require.NoError(t, err)
+
+	db := datastore.GetStore().GetTransaction(ctx)
+	root := &reference.Ref{Type: reference.DIRECTORY,
+		AllocationID: simulatedAllocationID, Name: "/", Path: "/", PathLevel: 1}
+	refs := []*reference.Ref{root}
+	for path, content := range files {
+		fileData := &filestore.FileInputData{Name: path[1:], Path: path}
+		out, err := filestore.GetFileStore().WriteFile(simulatedAllocationID,
+			fileData, filestoretest.NewMemFile([]byte(content)), "upload")
+		require.NoError(t, err)
+		fileData.Hash = out.ContentHash
+		_, err = filestore.GetFileStore().CommitWrite(simulatedAllocationID, fileData, "upload")
+		require.NoError(t, err)
+		refs = append(refs, &reference.Ref{Type: reference.FILE,
+			AllocationID: simulatedAllocationID, Name: path[1:], Path: path,
+			ParentPath: "/", PathLevel: 2, Size: out.Size,
+			ContentHash: out.ContentHash, MerkleRoot: out.MerkleRoot})
+	}
+	for _, ref := range refs {
+		ref.LookupHash = reference.GetReferenceLookup(simulatedAllocationID, ref.Path)
+		ref.PathHash = ref.LookupHash
+		require.NoError(t, db.Create(ref).Error)
+	}
+	tree, err := reference.GetObjectTree(ctx, simulatedAllocationID, "/")
+	require.NoError(t, err)
+	_, err = tree.CalculateHash(ctx, true)
+	require.NoError(t, err)
+
+	timestamp := common.Now()
+	wm := &writemarker.WriteMarkerEntity{
+		WM: writemarker.WriteMarker{
+			AllocationRoot: encryption.Hash(tree.Hash + ":" +
+				strconv.FormatInt(int64(timestamp), 10)),
+			AllocationID: simulatedAllocationID,
+			BlobberID:    "blobber",
+			Timestamp:    timestamp,
+			ClientID:     encryption.Hash(keyBytes),
+		},
+		Status:          writemarker.Committed,
+		ClientPublicKey: ownerKey,
+	}
+	wm.WM.Signature, err = owner.Sign(encryption.Hash(wm.WM.GetHashData()))
+	require.NoError(t, err)
+	require.NoError(t, db.Create(wm).Error)
+
+	require.NoError(t, db.Create(&allocation.Allocation{
+		ID:             simulatedAllocationID,
+		Tx:             simulatedAllocationID,
+		OwnerID:        wm.WM.ClientID,
+		OwnerPublicKey: ownerKey,
+		AllocationRoot: wm.WM.AllocationRoot,
+	}).Error)
+}
+
+func TestSimulateChallenges(t *testing.T) {
+	datastore.OpenTheSQLiteStore(t)
+	defer datastore.GetStore().Close()
+	_, err := filestore.SetupStore(filestore.MemoryStoreBackend, "")
+	require.NoError(t, err)
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+	storeSimulatedAllocation(t, ctx, map[string]string{
+		"/a.txt": "the content of the first file",
+		"/b.txt": "the content of the second file",
+	})
+
+	report, err := SimulateChallenges(ctx, simulatedAllocationID, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, report.Results, 10)
+	for _, result := range report.Results {
+		assert.True(t, result.Passed, result.Error)
+		assert.NotEmpty(t, result.Path)
+	}
+	assert.Empty(t, report.CorruptedFiles)
+
+	// the content of a file is lost
+	ref, err := reference.GetReference(ctx, simulatedAllocationID, "/a.txt")
+	require.NoError(t, err)
+	require.NoError(t, filestore.GetFileStore().DeleteFile(simulatedAllocationID, ref.ContentHash))
+
+	report, err = SimulateChallenges(ctx, simulatedAllocationID, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/a.txt"}, report.CorruptedFiles)
+	for _, result := range report.Results {
+		assert.Equal(t, result.Path == "/a.txt", result.Corrupted)
+		assert.Equal(t, result.Path != "/a.txt", result.Passed, result.Error)
+	}
+
+	// the content of the other one is corrupted, the validators fail it as
+	// they sign it into their tickets
+	ref, err = reference.GetReference(ctx, simulatedAllocationID, "/b.txt")
+	require.NoError(t, err)
+	fileData := &filestore.FileInputData{Name: "b.txt", Path: "/b.txt"}
+	_, err = filestore.GetFileStore().WriteFile(simulatedAllocationID, fileData,
+		filestoretest.NewMemFile([]byte("the corrupted second file")), "corruption")
+	require.NoError(t, err)
+	fileData.Hash = ref.ContentHash
+	_, err = filestore.GetFileStore().CommitWrite(simulatedAllocationID, fileData, "corruption")
+	require.NoError(t, err)
+
+	report, err = SimulateChallenges(ctx, simulatedAllocationID, 1, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"/a.txt", "/b.txt"}, report.CorruptedFiles)
+	for _, result := range report.Results {
+		assert.True(t, result.Corrupted)
+		if result.Path == "/b.txt" {
+			assert.Contains(t, result.Error, "challenge_validation_failed")
+		}
+	}
+
+	_, err = SimulateChallenges(ctx, simulatedAllocationID, 1, 0)
+	assert.Error(t, err)
+	_, err = SimulateChallenges(ctx, "unknown", 1, 1)
+	assert.Error(t, err)
+}
+
+func TestSimulateChallenges_NoValidatorMetrics(t *testing.T) {
+	// the verifying code of the validators registers none of their metrics
+	outcomes := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_challenges_total",
+		Help: "Number of challenge validation requests, by outcome: passed, failed or error.",
+	}, []string{"outcome"})
+	require.NoError(t, prometheus.Register(outcomes))
+	prometheus.Unregister(outcomes)
+}
@@ -0,0 +1,338 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+	. "0chain.net/core/logging"
+	"0chain.net/core/util"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// validatorStatsHalfLife is the time after which the responses and failures
+// of a validator weigh half as much in its reliability, for the validators
+// which failed for a while to recover.
+const validatorStatsHalfLife = 24 * time.Hour
+
+// ValidatorStats is the reliability of a validator, from the validation
+// tickets requested to it.
+type ValidatorStats struct {
+	ValidatorID string `gorm:"column:validator_id;primary_key" json:"validator_id"`
+	URL         string `gorm:"column:url" json:"url"`
+	// Responses is the number of valid tickets the validator responded
+	// with, Failures the number of requests it failed or timed out.
+	Responses int64 `gorm:"column:responses" json:"responses"`
+	Failures  int64 `gorm:"column:failures" json:"failures"`
+	// DecayedResponses and DecayedFailures are the same numbers decayed
+	// over validatorStatsHalfLife, as of UpdatedAt.
+	DecayedResponses float64    `gorm:"column:decayed_responses" json:"-"`
+	DecayedFailures  float64    `gorm:"column:decayed_failures" json:"-"`
+	TotalLatencyMs   int64      `gorm:"column:total_latency_ms" json:"-"`
+	LastResponseAt   *time.Time `gorm:"column:last_response_at" json:"last_response_at"`
+	LastFailureAt    *time.Time `gorm:"column:last_failure_at" json:"last_failure_at"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at" json:"updated_at"`
+	// Reliability is the estimated probability of a valid response, and
+	// AvgLatencyMs the mean time of the valid responses.
+	Reliability  float64 `gorm:"-" json:"reliability"`
+	AvgLatencyMs int64   `gorm:"-" json:"avg_latency_ms"`
+}
+
+func (ValidatorStats) TableName() string {
+	return "validator_stats"
+}
+
+// decay returns the weight left after the time elapsed of the responses and
+// failures.
+func decay(elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 1
+	}
+	return math.Exp2(-float64(elapsed) / float64(validatorStatsHalfLife))
+}
+
+func (vs *ValidatorStats) computeScores(now time.Time) {
+	// one response and one failure are assumed, for the validators new,
+	// barely or long ago requested to rank between the reliable and the
+	// failing ones
+	d := decay(now.Sub(vs.UpdatedAt))
+	responses, failures := vs.DecayedResponses*d, vs.DecayedFailures*d
+	vs.Reliability = (responses + 1) / (responses + failures + 2)
+	if vs.Responses > 0 {
+		vs.AvgLatencyMs = vs.TotalLatencyMs / vs.Responses
+	}
+}
+
+// add adds the response of the validator at the time.
+func (vs *ValidatorStats) add(resp *ticketResponse, now time.Time) {
+	d := decay(now.Sub(vs.UpdatedAt))
+	vs.DecayedResponses *= d
+	vs.DecayedFailures *= d
+	if resp.err == nil {
+		vs.Responses++
+		vs.DecayedResponses++
+		vs.TotalLatencyMs += resp.latency.Milliseconds()
+		vs.LastResponseAt = &now
+	} else {
+		vs.Failures++
+		vs.DecayedFailures++
+		vs.LastFailureAt = &now
+	}
+	vs.UpdatedAt = now
+}
+
+// GetValidatorStats returns the stats of the validators requested so far, the
+// most reliable first.
+func GetValidatorStats(ctx context.Context) ([]*ValidatorStats, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	stats := make([]*ValidatorStats, 0)
+	if err := db.Find(&stats).Error; err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, vs := range stats {
+		vs.computeScores(now)
+	}
+	sortValidatorStats(stats)
+	return stats, nil
+}
+
+func sortValidatorStats(stats []*ValidatorStats) {
+	sort.SliceStable(stats, func(i, j int) bool {
+		if stats[i].Reliability != stats[j].Reliability {
+			return stats[i].Reliability > stats[j].Reliability
+		}
+		return stats[i].AvgLatencyMs < stats[j].AvgLatencyMs
+	})
+}
+
+// rankValidators returns the indexes of the validators, the most reliable
+// first, in their order for the validators of the same reliability.
+func rankValidators(ctx context.Context, validators []ValidationNode) []int {
+	ids := make([]string, len(validators))
+	for i, validator := range validators {
+		ids[i] = validator.ID
+	}
+	known := make([]*ValidatorStats, 0)
+	err := datastore.GetStore().GetTransaction(ctx).
+		Where("validator_id IN ?", ids).Find(&known).Error
+	if err != nil {
+		Logger.Error("Error getting the validator stats", zap.Error(err))
+	}
+	byID := make(map[string]*ValidatorStats, len(known))
+	for _, vs := range known {
+		byID[vs.ValidatorID] = vs
+	}
+
+	now := time.Now()
+	stats := make([]*ValidatorStats, len(validators))
+	index := make(map[*ValidatorStats]int, len(validators))
+	for i, validator := range validators {
+		vs, ok := byID[validator.ID]
+		if !ok {
+			vs = &ValidatorStats{ValidatorID: validator.ID}
+		} else {
+			// the same validator may be assigned twice
+			copied := *vs
+			vs = &copied
+		}
+		vs.computeScores(now)
+		stats[i] = vs
+		index[vs] = i
+	}
+	sortValidatorStats(stats)
+	ranked := make([]int, len(stats))
+	for i, vs := range stats {
+		ranked[i] = index[vs]
+	}
+	return ranked
+}
+
+// ticketResponse is the outcome of a validation ticket request.
+type ticketResponse struct {
+	index   int
+	ticket  *ValidationTicket
+	err     error
+	latency time.Duration
+}
+
+// requestTicket requests the validation ticket of the challenge to the
+// validator, within the validator timeout.
+func requestTicket(ctx context.Context, challengeID string, index int,
+	validator ValidationNode, postData []byte, responses chan<- *ticketResponse) {
+
+	if timeout := config.Configuration.ChallengeValidatorTimeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	start := time.Now()
+	resp := &ticketResponse{index: index}
+	resp.ticket, resp.err = fetchTicket(ctx, challengeID, validator, postData)
+	resp.latency = time.Since(start)
+	responses <- resp
+}
+
+func fetchTicket(ctx context.Context, challengeID string, validator ValidationNode,
+	postData []byte) (*ValidationTicket, error) {
+
+	body, err := util.SendPostRequestContext(ctx, validator.URL+VALIDATOR_URL, postData)
+	if err != nil {
+		return nil, err
+	}
+	var vt ValidationTicket
+	if err = json.Unmarshal(body, &vt); err != nil {
+		return nil, common.NewError("invalid_validation_ticket",
+			"Error decoding the validation ticket: "+err.Error())
+	}
+	if vt.ChallengeID != challengeID {
+		return nil, common.NewError("invalid_validation_ticket",
+			"The validation ticket is for another challenge")
+	}
+	verified, err := vt.VerifySign()
+	if err != nil || !verified {
+		return nil, common.NewError("invalid_validation_ticket",
+			"The validation ticket signature could not be verified")
+	}
+	return &vt, nil
+}
+
+// collectValidationTickets requests the validation tickets of the challenge
+// to the validators which did not sign one yet, concurrently, the most
+// reliable first. It keeps as many requests in flight as tickets are missing
+// for a majority to agree, replaces the failed ones with the next validators,
+// and stops once a majority agrees.
+func (cr *ChallengeEntity) collectValidationTickets(ctx context.Context, postData []byte) {
+	var (
+		quorum              = len(cr.Validators)/2 + 1
+		successes, failures int
+		pending             []int
+	)
+	count := func(vt *ValidationTicket) {
+		if vt.Result {
+			successes++
+		} else {
+			failures++
+		}
+	}
+	for _, i := range rankValidators(ctx, cr.Validators) {
+		if vt := cr.ValidationTickets[i]; vt != nil && len(vt.Signature) > 0 &&
+			vt.ChallengeID == cr.ChallengeID {
+			count(vt)
+			continue
+		}
+		cr.ValidationTickets[i] = nil
+		pending = append(pending, i)
+	}
+
+	reqCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		responses = make(chan *ticketResponse, len(pending))
+		results   = make([]*ticketResponse, 0, len(pending))
+		inFlight  int
+	)
+	for {
+		needed := quorum - successes
+		if quorum-failures < needed {
+			needed = quorum - failures
+		}
+		if needed <= 0 {
+			break
+		}
+		for inFlight < needed && len(pending) > 0 {
+			i := pending[0]
+			pending = pending[1:]
+			inFlight++
+			go requestTicket(reqCtx, cr.ChallengeID, i, cr.Validators[i], postData, responses)
+		}
+		if inFlight == 0 {
+			break
+		}
+
+		resp := <-responses
+		inFlight--
+		results = append(results, resp)
+		if resp.err != nil {
+			Logger.Info("Got error from the validator.",
+				zap.String("challenge_id", cr.ChallengeID),
+				zap.String("validator_id", cr.Validators[resp.index].ID),
+				zap.Error(resp.err))
+			continue
+		}
+		Logger.Info("Got response from the validator.", zap.Any("validator_response", resp.ticket))
+		cr.ValidationTickets[resp.index] = resp.ticket
+		count(resp.ticket)
+	}
+
+	datastore.GetStore().OnCommit(ctx, func() {
+		cr.recordValidatorStats(results)
+	})
+}
+
+// recordValidatorStats adds the responses of the validators to their stats.
+// It runs once the challenge transaction is committed, for a failure not to
+// abort it, and the failures are logged only.
+func (cr *ChallengeEntity) recordValidatorStats(results []*ticketResponse) {
+	db := datastore.GetStore().GetDB()
+	for _, resp := range results {
+		validator := cr.Validators[resp.index]
+		if err := recordValidatorResponse(db, validator, resp); err != nil {
+			Logger.Error("Error recording the validator stats",
+				zap.String("challenge_id", cr.ChallengeID),
+				zap.String("validator_id", validator.ID), zap.Error(err))
+		}
+	}
+}
+
+// recordValidatorResponse adds the response to the stats of the validator.
+// The decayed numbers are computed from the stats read, which are updated
+// only if no other response was added meanwhile, or read again.
+func recordValidatorResponse(db *gorm.DB, validator ValidationNode, resp *ticketResponse) error {
+	for {
+		vs := &ValidatorStats{}
+		err := db.Where("validator_id = ?", validator.ID).Take(vs).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			vs = &ValidatorStats{ValidatorID: validator.ID, URL: validator.URL}
+			vs.add(resp, time.Now())
+			res := db.Clauses(clause.OnConflict{DoNothing: true}).Create(vs)
+			if res.Error != nil || res.RowsAffected > 0 {
+				return res.Error
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		responses, failures := vs.Responses, vs.Failures
+		vs.URL = validator.URL
+		vs.add(resp, time.Now())
+		res := db.Model(&ValidatorStats{}).
+			Where("validator_id = ? AND responses = ? AND failures = ?",
+				validator.ID, responses, failures).
+			Updates(map[string]interface{}{
+				"url":               vs.URL,
+				"responses":         vs.Responses,
+				"failures":          vs.Failures,
+				"decayed_responses": vs.DecayedResponses,
+				"decayed_failures":  vs.DecayedFailures,
+				"total_latency_ms":  vs.TotalLatencyMs,
+				"last_response_at":  vs.LastResponseAt,
+				"last_failure_at":   vs.LastFailureAt,
+				"updated_at":        vs.UpdatedAt,
+			})
+		if res.Error != nil || res.RowsAffected > 0 {
+			return res.Error
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+	coreconfig "0chain.net/core/config"
+	"0chain.net/core/encryption"
+	"0chain.net/core/logging"
+	"0chain.net/core/node"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const validatedChallengeID = "validated_challenge"
+
+// testValidator signs the tickets of the validated challenge, after its delay.
+type testValidator struct {
+	*httptest.Server
+	id       string
+	requests int32
+}
+
+func newTestValidator(t *testing.T, id string, delay time.Duration) *testValidator {
+	scheme := zcncrypto.NewSignatureScheme("bls0chain")
+	_, err := scheme.GenerateKeys()
+	require.NoError(t, err)
+
+	v := &testValidator{id: id}
+	v.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&v.requests, 1)
+		// read for the cancelled requests to be noticed
+		ioutil.ReadAll(r.Body)
+		verified, err := encryption.Verify(r.Header.Get(common.ClientKeyHeader),
+			r.Header.Get(common.ClientSignatureHeader), r.Header.Get("X-App-Request-Hash"))
+		if err != nil || !verified {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		vt := &ValidationTicket{ChallengeID: validatedChallengeID,
+			BlobberID: "blobber", ValidatorID: id,
+			ValidatorKey: scheme.GetPublicKey(), Result: true,
+			Timestamp: common.Now()}
+		vt.Signature, err = scheme.Sign(encryption.Hash(fmt.Sprintf("%v:%v:%v:%v:%v:%v",
+			vt.ChallengeID, vt.BlobberID, vt.ValidatorID, vt.ValidatorKey,
+			vt.Result, vt.Timestamp)))
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(vt)
+	}))
+	return v
+}
+
+func (v *testValidator) requested() int {
+	return int(atomic.LoadInt32(&v.requests))
+}
+
+func setupValidators(t *testing.T, delays ...time.Duration) ([]*testValidator, func()) {
+	logging.Logger = zap.NewNop()
+	prev, prevScheme := config.Configuration, coreconfig.Configuration.SignatureScheme
+	config.Configuration.ChallengeValidatorTimeout = 5 * time.Second
+	coreconfig.Configuration.SignatureScheme = "bls0chain"
+	datastore.OpenTheSQLiteStore(t)
+	blobber := zcncrypto.NewSignatureScheme("bls0chain")
+	wallet, err := blobber.GenerateKeys()
+	require.NoError(t, err)
+	node.Self.SetKeys(wallet.Keys[0].PublicKey, wallet.Keys[0].PrivateKey)
+
+	validators := make([]*testValidator, len(delays))
+	for i, delay := range delays {
+		validators[i] = newTestValidator(t, fmt.Sprintf("validator%d", i), delay)
+	}
+	return validators, func() {
+		for _, v := range validators {
+			v.Close()
+		}
+		datastore.GetStore().Close()
+		config.Configuration = prev
+		coreconfig.Configuration.SignatureScheme = prevScheme
+	}
+}
+
+// collect collects the tickets of the validated challenge in a transaction.
+func collect(t *testing.T, validators []*testValidator) *ChallengeEntity {
+	cr := &ChallengeEntity{ChallengeID: validatedChallengeID,
+		ValidationTickets: make([]*ValidationTicket, len(validators))}
+	for _, v := range validators {
+		cr.Validators = append(cr.Validators, ValidationNode{ID: v.id, URL: v.URL})
+	}
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	cr.collectValidationTickets(ctx, []byte("{}"))
+	require.NoError(t, datastore.GetStore().Commit(ctx))
+	return cr
+}
+
+func validatorStats(t *testing.T) map[string]*ValidatorStats {
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+	stats, err := GetValidatorStats(ctx)
+	require.NoError(t, err)
+	byID := make(map[string]*ValidatorStats)
+	for _, vs := range stats {
+		byID[vs.ValidatorID] = vs
+	}
+	return byID
+}
+
+func TestCollectValidationTickets_Quorum(t *testing.T) {
+	validators, teardown := setupValidators(t, 0, 0, 0)
+	defer teardown()
+
+	cr := collect(t, validators)
+	assert.NotNil(t, cr.ValidationTickets[0])
+	assert.NotNil(t, cr.ValidationTickets[1])
+	assert.Nil(t, cr.ValidationTickets[2])
+	assert.Equal(t, 0, validators[2].requested())
+
+	// the tickets already signed are kept
+	cr.ValidationTickets[1] = nil
+	cr.ValidationTickets[2] = nil
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	cr.collectValidationTickets(ctx, []byte("{}"))
+	require.NoError(t, datastore.GetStore().Commit(ctx))
+	assert.Equal(t, 1, validators[0].requested())
+	assert.Equal(t, 2, validators[1].requested())
+
+	stats := validatorStats(t)
+	require.Len(t, stats, 2)
+	assert.EqualValues(t, 1, stats["validator0"].Responses)
+	assert.EqualValues(t, 2, stats["validator1"].Responses)
+	assert.EqualValues(t, 0, stats["validator1"].Failures)
+	assert.Equal(t, validators[1].URL, stats["validator1"].URL)
+	assert.NotNil(t, stats["validator1"].LastResponseAt)
+}
+
+func TestCollectValidationTickets_PreferReliable(t *testing.T) {
+	validators, teardown := setupValidators(t, 0, 0, 0)
+	defer teardown()
+
+	db := datastore.GetStore().GetDB()
+	require.NoError(t, db.Create(&ValidatorStats{ValidatorID: "validator0",
+		Failures: 5, DecayedFailures: 5, UpdatedAt: time.Now()}).Error)
+	require.NoError(t, db.Create(&ValidatorStats{ValidatorID: "validator2",
+		Responses: 5, DecayedResponses: 5, UpdatedAt: time.Now()}).Error)
+
+	cr := collect(t, validators)
+	assert.Nil(t, cr.ValidationTickets[0])
+	assert.NotNil(t, cr.ValidationTickets[1])
+	assert.NotNil(t, cr.ValidationTickets[2])
+	assert.Equal(t, 0, validators[0].requested())
+}
+
+func TestCollectValidationTickets_Timeout(t *testing.T) {
+	validators, teardown := setupValidators(t, time.Minute, 0, 0)
+	defer teardown()
+	config.Configuration.ChallengeValidatorTimeout = 50 * time.Millisecond
+
+	cr := collect(t, validators)
+	assert.Nil(t, cr.ValidationTickets[0])
+	assert.NotNil(t, cr.ValidationTickets[1])
+	assert.NotNil(t, cr.ValidationTickets[2])
+
+	stats := validatorStats(t)
+	require.Len(t, stats, 3)
+	assert.EqualValues(t, 1, stats["validator0"].Failures)
+	assert.NotNil(t, stats["validator0"].LastFailureAt)
+	assert.EqualValues(t, 1, stats["validator2"].Responses)
+	assert.Less(t, stats["validator0"].Reliability, stats["validator2"].Reliability)
+}
+
+func TestCollectValidationTickets_StatsFailure(t *testing.T) {
+	validators, teardown := setupValidators(t, 0, 0, 0)
+	defer teardown()
+	require.NoError(t, datastore.GetStore().GetDB().Exec("DROP TABLE validator_stats").Error)
+
+	// the challenge is committed all the same
+	cr := collect(t, validators)
+	assert.NotNil(t, cr.ValidationTickets[0])
+	assert.NotNil(t, cr.ValidationTickets[1])
+}
+
+func TestValidatorStats_Decay(t *testing.T) {
+	now := time.Now()
+	failing := &ValidatorStats{Failures: 20, DecayedFailures: 20, UpdatedAt: now}
+	failing.computeScores(now)
+	assert.InDelta(t, 1.0/22, failing.Reliability, 1e-9)
+
+	// the failures weigh half as much after the half-life
+	failing.computeScores(now.Add(validatorStatsHalfLife))
+	assert.InDelta(t, 1.0/12, failing.Reliability, 1e-9)
+
+	// and the validator ranks as a new one once they are long past
+	failing.computeScores(now.Add(20 * validatorStatsHalfLife))
+	assert.InDelta(t, 0.5, failing.Reliability, 1e-3)
+
+	// a response after the half-life adds to the decayed numbers
+	failing.add(&ticketResponse{}, now.Add(validatorStatsHalfLife))
+	assert.EqualValues(t, 1, failing.Responses)
+	assert.EqualValues(t, 20, failing.Failures)
+	assert.InDelta(t, 1, failing.DecayedResponses, 1e-9)
+	assert.InDelta(t, 10, failing.DecayedFailures, 1e-9)
+}
@@ -24,6 +24,20 @@ func SetupDefaultConfig() {
 	viper.SetDefault("challenge_response.frequency", 10)
 	viper.SetDefault("challenge_response.num_workers", 5)
 	viper.SetDefault("challenge_response.max_retries", 10)
+	viper.SetDefault("challenge_response.retry_backoff", 5*time.Second)
+	viper.SetDefault("challenge_response.max_retry_backoff", time.Minute)
+	viper.SetDefault("challenge_response.validator_timeout", 10*time.Second)
+
+	viper.SetDefault("db.driver", "postgres")
+	viper.SetDefault("db.path", "data/blobber_meta.db")
+	viper.SetDefault("db.auto_migrate", true)
+	viper.SetDefault("filestore.backend", "local")
+	viper.SetDefault("filestore.dedup", false)
+	viper.SetDefault("filestore.compression.enabled", false)
+	viper.SetDefault("filestore.compression.mime_types", []string{
+		"text/", "application/json", "application/xml",
+		"application/javascript", "application/x-tar", "image/svg+xml",
+	})
 
 	viper.SetDefault("capacity", -1)
 	viper.SetDefault("read_price", 0.0)
@@ -33,6 +47,23 @@ func SetupDefaultConfig() {
 	viper.SetDefault("challenge_completion_time", time.Duration(-1))
 	viper.SetDefault("read_lock_timeout", time.Duration(-1))
 	viper.SetDefault("write_lock_timeout", time.Duration(-1))
+	viper.SetDefault("max_batch_upload_files", 1000)
+	viper.SetDefault("max_thumbnail_size", 1024*1024)
+	viper.SetDefault("reference_snapshots", 0)
+	viper.SetDefault("file_versions.max_versions", 0)
+	viper.SetDefault("file_versions.retention", time.Duration(0))
+	viper.SetDefault("file_versions.prune_interval", time.Hour)
+	viper.SetDefault("trash.retention", time.Duration(0))
+	viper.SetDefault("trash.purge_interval", time.Hour)
+	viper.SetDefault("webhooks.max_attempts", 8)
+	viper.SetDefault("webhooks.retry_backoff", 10*time.Second)
+	viper.SetDefault("webhooks.max_retry_backoff", time.Hour)
+	viper.SetDefault("webhooks.timeout", 10*time.Second)
+	viper.SetDefault("webhooks.log_retention", 7*24*time.Hour)
+	viper.SetDefault("webhooks.capacity_threshold", 0.0)
+	viper.SetDefault("scrubber.interval", 24*time.Hour)
+	viper.SetDefault("scrubber.bytes_per_second", 10*1024*1024)
+	viper.SetDefault("scrubber.restore_from_cloud", false)
 
 	viper.SetDefault("delegate_wallet", "")
 	viper.SetDefault("min_stake", 1.0)
@@ -68,8 +99,23 @@ type GeolocationConfig struct {
 	Longitude float64 `mapstructure:"longitude"`
 }
 
+// WebhookConfig is a webhook notified of the Events, all the events if
+// empty.
+type WebhookConfig struct {
+	URL    string   `mapstructure:"url"`
+	Secret string   `mapstructure:"secret" json:"-"`
+	Events []string `mapstructure:"events"`
+}
+
 type Config struct {
 	*config.Config
+	// DBDriver is the metadata database: postgres, or sqlite for single node
+	// deployments keeping the database in the DBPath file.
+	DBDriver string
+	DBPath   string
+	// DBAutoMigrate applies the pending schema migrations on start, else the
+	// blobber refuses to start until they are applied with --migrate.
+	DBAutoMigrate                 bool
 	DBHost                        string
 	DBPort                        string
 	DBName                        string
@@ -86,9 +132,64 @@ type Config struct {
 	ChallengeResolveFreq          int64
 	ChallengeResolveNumWorkers    int
 	ChallengeMaxRetires           int
+	ChallengeRetryBackoff         time.Duration
+	ChallengeMaxRetryBackoff      time.Duration
+	ChallengeValidatorTimeout     time.Duration
 	TempFilesCleanupFreq          int64
 	TempFilesCleanupNumWorkers    int
 	MaxFileSize                   int64
+	MaxThumbnailSize              int64
+	// MaxBatchUploadFiles is the max number of files of a batch upload.
+	MaxBatchUploadFiles int
+	// ReferenceSnapshots is the number of reference trees kept per
+	// allocation, one per write marker, to roll the allocation back to. Zero
+	// disables the snapshots and the rollback.
+	ReferenceSnapshots int
+	// MaxFileVersions and FileVersionRetention are the defaults of the new
+	// allocations for the number of previous versions kept per updated file
+	// and how long they are kept. The versions past the retention are pruned
+	// every FileVersionPruneInterval.
+	MaxFileVersions          int
+	FileVersionRetention     time.Duration
+	FileVersionPruneInterval time.Duration
+	// TrashRetention is how long the deleted files are kept in the trash of
+	// their allocation, to be restored, before being purged every
+	// TrashPurgeInterval. Zero disables the trash.
+	TrashRetention     time.Duration
+	TrashPurgeInterval time.Duration
+	// Webhooks are notified of the events of the blobber, with payloads
+	// signed with their secrets. A delivery is attempted WebhookMaxAttempts
+	// times at most, after a backoff doubling from WebhookRetryBackoff up to
+	// WebhookMaxRetryBackoff, and kept in the delivery log for
+	// WebhookLogRetention. The capacity threshold event is notified once the
+	// fraction WebhookCapacityThreshold of the capacity is used, zero for
+	// never.
+	Webhooks                 []WebhookConfig
+	WebhookMaxAttempts       int
+	WebhookRetryBackoff      time.Duration
+	WebhookMaxRetryBackoff   time.Duration
+	WebhookTimeout           time.Duration
+	WebhookLogRetention      time.Duration
+	WebhookCapacityThreshold float64
+	// ScrubInterval is the interval at which the stored files are re-read
+	// and checked against their content hash and merkle root, zero for
+	// never. The reads are limited to ScrubBytesPerSecond, zero for no
+	// limit. With ScrubRestoreFromCloud, the corrupted files having a cloud
+	// copy are restored from it.
+	ScrubInterval         time.Duration
+	ScrubBytesPerSecond   int64
+	ScrubRestoreFromCloud bool
+
+	// FileStoreBackend is the name of the registered filestore backend
+	// holding the primary content (local, s3 or memory).
+	FileStoreBackend string
+	// FileStoreDedup stores identical content once across allocations,
+	// local backend only.
+	FileStoreDedup bool
+	// FileStoreCompression compresses content of the FileStoreCompressMimeTypes
+	// (mime type prefixes) at commit time, local backend only.
+	FileStoreCompression       bool
+	FileStoreCompressMimeTypes []string
 
 	ColdStorageMinimumFileSize   int64
 	ColdStorageTimeLimitInHours  int64
@@ -0,0 +1,226 @@
+package datastore
+
+import (
+	"0chain.net/core/common"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	. "0chain.net/core/logging"
+)
+
+// Migration is a numbered change of the schema, with its statements for each
+// of the drivers. Empty statements only record the version.
+type Migration struct {
+	Version  int64
+	Name     string
+	Postgres string
+	SQLite   string
+}
+
+// SchemaMigration is the record of an applied migration.
+type SchemaMigration struct {
+	Version   int64            `gorm:"column:version;primary_key"`
+	Name      string           `gorm:"column:name"`
+	AppliedAt common.Timestamp `gorm:"column:applied_at"`
+}
+
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+const createSchemaMigrations = `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT NOT NULL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    applied_at BIGINT NOT NULL
+)`
+
+// legacyVersion is the version of the databases created by the sql/*.sql
+// scripts, before the migrations were tracked.
+const legacyVersion = 14
+
+// legacyScripts tell whether the sql/*.sql script of each legacy migration
+// was applied, for a database upgraded part of the way to be recorded at the
+// version it reached.
+var legacyScripts = map[int64]func(db *gorm.DB) bool{
+	1:  hasTable("allocations"),
+	2:  func(*gorm.DB) bool { return true }, // grants only
+	3:  hasColumn("allocations", "tx"),
+	4:  hasColumn("reference_objects", "on_cloud"),
+	5:  hasTable("commit_meta_txns"),
+	6:  hasColumn("allocations", "finalized"),
+	7:  hasColumn("terms", "allocation_id"),
+	8:  hasColumn("allocations", "payer_id"),
+	9:  hasColumn("read_markers", "suspend"),
+	10: hasColumn("allocations", "time_unit"),
+	11: hasColumn("read_markers", "auth_ticket"),
+	12: hasColumn("reference_objects", "attributes"),
+	13: hasTable("collaborators"),
+	14: hasColumnWiderThan("allocations", "owner_public_key", 256),
+}
+
+func hasTable(table string) func(db *gorm.DB) bool {
+	return func(db *gorm.DB) bool {
+		return db.Migrator().HasTable(table)
+	}
+}
+
+func hasColumn(table, column string) func(db *gorm.DB) bool {
+	return func(db *gorm.DB) bool {
+		var count int64
+		query := "SELECT count(*) FROM information_schema.columns WHERE table_schema = CURRENT_SCHEMA() AND table_name = ? AND column_name = ?"
+		if db.Dialector.Name() == SQLiteDriver {
+			query = "SELECT count(*) FROM pragma_table_info(?) WHERE name = ?"
+		}
+		return db.Raw(query, table, column).Row().Scan(&count) == nil && count > 0
+	}
+}
+
+func hasColumnWiderThan(table, column string, length int64) func(db *gorm.DB) bool {
+	return func(db *gorm.DB) bool {
+		if db.Dialector.Name() == SQLiteDriver {
+			// the SQLite schema is created at once, lengths are not enforced
+			return hasColumn(table, column)(db)
+		}
+		var width int64
+		err := db.Raw("SELECT COALESCE(character_maximum_length, 0) FROM information_schema.columns WHERE table_schema = CURRENT_SCHEMA() AND table_name = ? AND column_name = ?",
+			table, column).Row().Scan(&width)
+		return err == nil && width > length
+	}
+}
+
+// LatestSchemaVersion is the version of the schema this blobber works with.
+func LatestSchemaVersion() int64 {
+	return migrations[len(migrations)-1].Version
+}
+
+// SchemaVersion returns the latest applied migration, zero for an empty
+// database.
+func (store *Store) SchemaVersion() (int64, error) {
+	if err := store.initSchemaMigrations(); err != nil {
+		return 0, err
+	}
+	return store.schemaVersion()
+}
+
+// CheckSchema fails unless all the migrations are applied, and no other.
+func (store *Store) CheckSchema() error {
+	version, err := store.SchemaVersion()
+	if err != nil {
+		return err
+	}
+	if version > LatestSchemaVersion() {
+		return schemaTooNewError(version)
+	}
+	if version < LatestSchemaVersion() {
+		return common.NewErrorf("schema_outdated",
+			"schema version %d is older than %d, migrate the database",
+			version, LatestSchemaVersion())
+	}
+	return nil
+}
+
+// Migrate applies the pending migrations, each in its own transaction, and
+// returns the number applied. It refuses a schema newer than the latest
+// migration known.
+func (store *Store) Migrate() (int, error) {
+	version, err := store.SchemaVersion()
+	if err != nil {
+		return 0, err
+	}
+	if version > LatestSchemaVersion() {
+		return 0, schemaTooNewError(version)
+	}
+
+	var applied int
+	for _, m := range migrations {
+		if m.Version <= version {
+			continue
+		}
+		if err = store.applyMigration(m); err != nil {
+			return applied, err
+		}
+		applied++
+		Logger.Info("Applied schema migration",
+			zap.Int64("version", m.Version), zap.String("name", m.Name))
+	}
+	return applied, nil
+}
+
+func (store *Store) applyMigration(m Migration) error {
+	var statements = m.Postgres
+	if store.db.Dialector.Name() == SQLiteDriver {
+		statements = m.SQLite
+	}
+
+	err := store.db.Transaction(func(tx *gorm.DB) error {
+		if statements != "" {
+			if err := tx.Exec(statements).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(&SchemaMigration{
+			Version:   m.Version,
+			Name:      m.Name,
+			AppliedAt: common.Now(),
+		}).Error
+	})
+	if err != nil {
+		return common.NewErrorf("migration_failed",
+			"migration %d %s: %v", m.Version, m.Name, err)
+	}
+	return nil
+}
+
+// initSchemaMigrations creates the table of the applied migrations. On a
+// database created by the sql/*.sql scripts, the ones applied in sequence
+// are recorded as such.
+func (store *Store) initSchemaMigrations() error {
+	if store.db.Migrator().HasTable(&SchemaMigration{}) {
+		return nil
+	}
+	var applied []Migration
+	for _, m := range migrations {
+		if m.Version > legacyVersion || !legacyScripts[m.Version](store.db) {
+			break
+		}
+		applied = append(applied, m)
+	}
+
+	return store.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(createSchemaMigrations).Error; err != nil {
+			return common.NewError("migration_failed", err.Error())
+		}
+		if len(applied) == 0 {
+			return nil
+		}
+		Logger.Info("Recording the schema of the sql scripts",
+			zap.Int64("version", applied[len(applied)-1].Version))
+		for _, m := range applied {
+			err := tx.Create(&SchemaMigration{
+				Version:   m.Version,
+				Name:      m.Name,
+				AppliedAt: common.Now(),
+			}).Error
+			if err != nil {
+				return common.NewError("migration_failed", err.Error())
+			}
+		}
+		return nil
+	})
+}
+
+func (store *Store) schemaVersion() (version int64, err error) {
+	err = store.db.Model(&SchemaMigration{}).
+		Select("COALESCE(MAX(version), 0)").Row().Scan(&version)
+	if err != nil {
+		return 0, common.NewError("schema_version_error", err.Error())
+	}
+	return
+}
+
+func schemaTooNewError(version int64) error {
+	return common.NewErrorf("schema_too_new",
+		"schema version %d is newer than %d, upgrade the blobber",
+		version, LatestSchemaVersion())
+}
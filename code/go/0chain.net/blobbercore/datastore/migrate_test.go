@@ -0,0 +1,91 @@
+package datastore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"0chain.net/core/common"
+	"0chain.net/core/logging"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func init() {
+	logging.Logger = zap.NewNop()
+}
+
+func TestStore_Migrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blobber_meta")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "meta.db")
+
+	var s Store
+	require.NoError(t, s.openSQLite(path))
+	assert.Error(t, s.CheckSchema())
+	applied, err := s.Migrate()
+	require.NoError(t, err)
+	assert.Equal(t, len(migrations), applied)
+	require.NoError(t, s.CheckSchema())
+	s.Close()
+
+	// nothing is pending on the next start
+	require.NoError(t, s.openSQLite(path))
+	defer s.Close()
+	applied, err = s.Migrate()
+	require.NoError(t, err)
+	assert.Zero(t, applied)
+	version, err := s.SchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, LatestSchemaVersion(), version)
+}
+
+func TestStore_MigrateLegacySchema(t *testing.T) {
+	var s Store
+	require.NoError(t, s.openSQLite(":memory:"))
+	defer s.Close()
+	// as created before the migrations were tracked
+	require.NoError(t, s.db.Exec(sqliteSchema).Error)
+
+	version, err := s.SchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, int64(legacyVersion), version)
+	_, err = s.Migrate()
+	require.NoError(t, err)
+}
+
+func TestStore_MigratePartialLegacySchema(t *testing.T) {
+	var s Store
+	require.NoError(t, s.openSQLite(":memory:"))
+	defer s.Close()
+	// as upgraded by the sql scripts up to the 12th
+	require.NoError(t, s.db.Exec(sqliteSchema).Error)
+	require.NoError(t, s.db.Exec("DROP TABLE collaborators").Error)
+
+	version, err := s.SchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), version)
+}
+
+func TestStore_MigrateSchemaTooNew(t *testing.T) {
+	var s Store
+	require.NoError(t, s.openSQLite(":memory:"))
+	defer s.Close()
+	_, err := s.Migrate()
+	require.NoError(t, err)
+
+	require.NoError(t, s.db.Create(&SchemaMigration{
+		Version:   LatestSchemaVersion() + 1,
+		Name:      "from_the_future",
+		AppliedAt: common.Now(),
+	}).Error)
+
+	_, err = s.Migrate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "schema_too_new")
+	assert.Error(t, s.CheckSchema())
+}
@@ -0,0 +1,729 @@
+package datastore
+
+// migrations are the changes of the schema, in order of their versions. The
+// versions follow the numbers of the former sql/*.sql scripts, whose database
+// and user creation (sql/00-create-user.sql) is still run by the database
+// administrator. The tables are owned by the blobber user which applies the
+// migrations, so the scripts granting privileges on them are empty.
+//
+// SQLite databases start from the schema of version 14 created by version 1,
+// any later migration must come with the statements of both drivers.
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "create_table",
+		Postgres: `
+CREATE OR REPLACE FUNCTION update_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TABLE allocations(
+    id VARCHAR (64) PRIMARY KEY,
+    size BIGINT NOT NULL DEFAULT 0,
+    used_size BIGINT NOT NULL DEFAULT 0,
+    owner_id VARCHAR(64) NOT NULL,
+    owner_public_key VARCHAR(256) NOT NULL,
+    expiration_date BIGINT NOT NULL,
+    allocation_root VARCHAR(255) NOT NULL DEFAULT '',
+    blobber_size BIGINT NOT NULL DEFAULT 0,
+    blobber_size_used BIGINT NOT NULL DEFAULT 0,
+    latest_redeemed_write_marker VARCHAR(255),
+    is_redeem_required BOOLEAN,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER allocation_modtime BEFORE UPDATE ON allocations FOR EACH ROW EXECUTE PROCEDURE  update_modified_column();
+
+CREATE TABLE allocation_connections(
+    connection_id VARCHAR (64) PRIMARY KEY,
+    allocation_id VARCHAR(64) NOT NULL,
+    client_id VARCHAR(64) NOT NULL,
+    size BIGINT NOT NULL DEFAULT 0,
+    status INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER allocation_connections_modtime BEFORE UPDATE ON allocation_connections FOR EACH ROW EXECUTE PROCEDURE  update_modified_column();
+
+CREATE TABLE allocation_changes(
+    id BIGSERIAL PRIMARY KEY,
+    connection_id VARCHAR (64) REFERENCES allocation_connections(connection_id),
+    operation VARCHAR(64) NOT NULL,
+    size BIGINT NOT NULL DEFAULT 0,
+    input TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER allocation_changes_modtime BEFORE UPDATE ON allocation_changes FOR EACH ROW EXECUTE PROCEDURE  update_modified_column();
+
+CREATE TABLE reference_objects (
+    id BIGSERIAL PRIMARY KEY,
+    lookup_hash VARCHAR (64) NOT NULL,
+    path_hash VARCHAR (64) NOT NULL,
+    type VARCHAR(10) NOT NULL,
+    allocation_id VARCHAR(64) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    path TEXT NOT NULL,
+    hash VARCHAR(64) NOT NULL,
+    num_of_blocks BIGINT NOT NULL DEFAULT 0,
+    parent_path TEXT,
+    level INT NOT NULL DEFAULT 0,
+    custom_meta TEXT NOT NULL,
+    content_hash VARCHAR(64) NOT NULL,
+    size BIGINT NOT NULL DEFAULT 0,
+    merkle_root VARCHAR(64) NOT NULL,
+    actual_file_size BIGINT NOT NULL DEFAULT 0,
+    actual_file_hash VARCHAR(64) NOT NULL,
+    mimetype VARCHAR(64) NOT NULL,
+    write_marker VARCHAR(64) NOT NULL,
+    thumbnail_hash VARCHAR(64) NOT NULL,
+    thumbnail_size BIGINT NOT NULL DEFAULT 0,
+    actual_thumbnail_size BIGINT NOT NULL DEFAULT 0,
+    actual_thumbnail_hash VARCHAR(64) NOT NULL,
+    encrypted_key TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE TRIGGER reference_objects_modtime BEFORE UPDATE ON reference_objects FOR EACH ROW EXECUTE PROCEDURE  update_modified_column();
+
+CREATE TABLE write_markers (
+    allocation_root VARCHAR (64) PRIMARY KEY,
+    prev_allocation_root VARCHAR (64) NOT NULL,
+    allocation_id VARCHAR(64) NOT NULL,
+    client_id VARCHAR(64) NOT NULL,
+    blobber_id VARCHAR(64) NOT NULL,
+    size BIGINT NOT NULL DEFAULT 0,
+    timestamp BIGINT NOT NULL,
+    signature VARCHAR(256) NOT NULL,
+    status INT NOT NULL DEFAULT 0,
+    status_message TEXT,
+    redeem_retries INT NOT NULL DEFAULT 0,
+    close_txn_id VARCHAR(64),
+    connection_id VARCHAR(64) NOT NULL,
+    client_key VARCHAR(256) NOT NULL,
+    sequence BIGSERIAL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER write_markers_modtime BEFORE UPDATE ON write_markers FOR EACH ROW EXECUTE PROCEDURE  update_modified_column();
+
+CREATE TABLE read_markers (
+    client_id VARCHAR(64) NOT NULL PRIMARY KEY,
+    client_public_key VARCHAR(256) NOT NULL,
+    blobber_id VARCHAR(64) NOT NULL,
+    allocation_id VARCHAR(64) NOT NULL,
+    owner_id VARCHAR(64) NOT NULL,
+    timestamp BIGINT NOT NULL,
+    counter BIGINT NOT NULL DEFAULT 0,
+    signature VARCHAR(256) NOT NULL,
+    latest_redeemed_rm JSON,
+    redeem_required boolean,
+    latest_redeem_txn_id VARCHAR(64),
+    status_message TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER read_markers_modtime BEFORE UPDATE ON read_markers FOR EACH ROW EXECUTE PROCEDURE  update_modified_column();
+
+CREATE TABLE challenges (
+    challenge_id VARCHAR(64) NOT NULL PRIMARY KEY,
+    prev_challenge_id VARCHAR(64),
+    seed BIGINT NOT NULL DEFAULT 0,
+    allocation_id VARCHAR(64) NOT NULL,
+    allocation_root VARCHAR(255),
+    responded_allocation_root VARCHAR(255),
+    status INT NOT NULL DEFAULT 0,
+    result INT NOT NULL DEFAULT 0,
+    status_message TEXT,
+    commit_txn_id VARCHAR(64),
+    block_num BIGINT,
+    ref_id BIGINT,
+    validation_tickets JSON,
+    validators JSON,
+    last_commit_txn_ids JSON,
+    object_path JSON,
+    sequence BIGSERIAL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER challenges_modtime BEFORE UPDATE ON challenges FOR EACH ROW EXECUTE PROCEDURE  update_modified_column();
+
+CREATE TABLE file_stats (
+    id BIGSERIAL PRIMARY KEY,
+    ref_id BIGINT UNIQUE REFERENCES reference_objects(id),
+    num_of_updates BIGINT,
+    num_of_block_downloads BIGINT,
+    num_of_challenges BIGINT,
+    num_of_failed_challenges BIGINT,
+    last_challenge_txn VARCHAR(64),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER file_stats_modtime BEFORE UPDATE ON file_stats FOR EACH ROW EXECUTE PROCEDURE  update_modified_column();
+`,
+		SQLite: sqliteSchema,
+	},
+	{
+		Version: 2,
+		Name:    "grant_priv",
+	},
+	{
+		Version: 3,
+		Name:    "add_allocation_prices",
+		Postgres: `
+ALTER TABLE allocations ADD COLUMN tx varchar (64) NOT NULL;
+
+CREATE UNIQUE INDEX idx_unique_allocations_tx ON allocations (tx);
+
+CREATE TABLE terms (
+    id             bigserial,
+
+    blobber_id     varchar(64) NOT NULL,
+    allocation_tx  varchar(64) REFERENCES allocations (tx),
+
+    read_price     bigint NOT NULL,
+    write_price    bigint NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- clients' pending reads / writes
+CREATE TABLE pendings (
+    id             bigserial,
+
+    client_id      varchar(64) NOT NULL,
+    allocation_id  varchar(64) NOT NULL,
+    blobber_id     varchar(64) NOT NULL,
+
+    pending_read   bigint NOT NULL DEFAULT 0, -- number of pending blocks
+    pending_write  bigint NOT NULL DEFAULT 0, -- number of pending bytes
+
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_pendings_cab
+    ON pendings (client_id, allocation_id, blobber_id);
+
+CREATE TABLE read_pools (
+    pool_id        text NOT NULL, -- unique
+
+    client_id      varchar(64) NOT NULL,
+    blobber_id     varchar(64) NOT NULL,
+    allocation_id  varchar(64) NOT NULL,
+
+    balance        bigint NOT NULL,
+    expire_at      bigint NOT NULL,
+
+    PRIMARY KEY (pool_id)
+);
+
+CREATE UNIQUE INDEX idx_read_pools_cab
+    ON read_pools (client_id, allocation_id, blobber_id);
+
+CREATE TABLE write_pools (
+    pool_id        text NOT NULL, -- unique
+
+    client_id      varchar(64) NOT NULL,
+    blobber_id     varchar(64) NOT NULL,
+    allocation_id  varchar(64) NOT NULL,
+
+    balance        bigint NOT NULL,
+    expire_at      bigint NOT NULL,
+
+    PRIMARY KEY (pool_id)
+);
+
+CREATE UNIQUE INDEX idx_write_pools_cab
+    ON write_pools (client_id, allocation_id, blobber_id);
+
+CREATE TABLE read_redeems (
+    id             bigserial,
+
+    read_counter   bigint NOT NULL,
+    value          bigint NOT NULL,
+
+    client_id      varchar(64) NOT NULL,
+    blobber_id     varchar(64) NOT NULL,
+    allocation_id  varchar(64) NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE write_redeems (
+    id             bigserial,
+
+    signature      varchar(256) NOT NULL, -- write marker signature
+
+    size           bigint NOT NULL,
+    value          bigint NOT NULL,
+
+    client_id      varchar(64) NOT NULL,
+    blobber_id     varchar(64) NOT NULL,
+    allocation_id  varchar(64) NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_write_redeems_signature ON write_redeems (signature);
+`,
+	},
+	{
+		Version: 4,
+		Name:    "add_on_cloud",
+		Postgres: `
+ALTER TABLE reference_objects ADD COLUMN on_cloud BOOLEAN DEFAULT FALSE;
+`,
+	},
+	{
+		Version: 5,
+		Name:    "add_commit_meta_txns_table",
+		Postgres: `
+CREATE TABLE commit_meta_txns (
+    ref_id BIGSERIAL NOT NULL,
+    txn_id VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+`,
+	},
+	{
+		Version: 6,
+		Name:    "add_cleaned_up_column_to_allocations",
+		Postgres: `
+ALTER TABLE allocations
+    ADD COLUMN cleaned_up boolean NOT NULL DEFAULT false;
+
+ALTER TABLE allocations
+    ADD COLUMN finalized boolean NOT NULL DEFAULT false;
+`,
+	},
+	{
+		Version: 7,
+		Name:    "terms_belongs_to_allocation_id",
+		Postgres: `
+ALTER TABLE terms
+    ADD COLUMN allocation_id varchar(64) REFERENCES allocations (id);
+
+UPDATE terms AS t
+SET allocation_id = a.id
+FROM allocations AS a
+WHERE t.allocation_tx = a.tx;
+
+ALTER TABLE terms DROP COLUMN allocation_tx;
+
+-- drop unique index
+DROP INDEX idx_read_pools_cab;
+DROP INDEX idx_write_pools_cab;
+
+-- create non-unique
+CREATE INDEX idx_read_pools_cab
+    ON read_pools (client_id, allocation_id, blobber_id);
+CREATE INDEX idx_write_pools_cab
+    ON write_pools (client_id, allocation_id, blobber_id);
+`,
+	},
+	{
+		Version: 8,
+		Name:    "add_payer_id_to_allocations",
+		Postgres: `
+ALTER TABLE allocations ADD COLUMN payer_id VARCHAR(64) NOT NULL;
+`,
+	},
+	{
+		Version: 9,
+		Name:    "add_suspend_column_to_read_markers",
+		Postgres: `
+-- if the suspend is equal to 'counter' column, then we don't send the marker
+-- even if its 'redeem_required' column is set to true; for a case, where we
+-- can't redeem a read_marker due to 'not enough tokens in related read pools'
+-- then we have to suspend redeeming to avoid 0chain DDoSing; in this case user
+-- can't read more files until his lock more tokens; and when hi locks more
+-- tokens, and reads a file, then the counter will be increased and a suspended
+-- read  marker will be awoken and redeemed
+--
+-- the case we can't redeem a read marker is a slow redeeming; there is
+-- 'read_lock_timeout' configuration, that means an expected timeout between
+-- a reading pre-redeeming and its redeeming; if redeeming works slow for a
+-- reason; then we can try to redeem a read_maker from read_pools already
+-- expired
+--
+
+ALTER TABLE read_markers
+    ADD COLUMN suspend BIGINT NOT NULL DEFAULT -1;
+
+--
+-- we don't need to track pending reads anymore
+--
+
+ALTER TABLE pendings
+    DROP COLUMN pending_read;
+
+--
+-- pending values has changed from tokens to number of block for read markers
+-- and size in bytes for write markers; we have to reset all of them to zero
+-- to avoid 'tokens * tokens' multiplication in pending tokens calculations
+-- (instead of 'size * tokens' or 'numBlocks * tokens')
+--
+
+-- (we are doing it in transaction to make sure it called once; since the
+-- alter table above, and drop table below fails next time and rolls back
+-- the transaction; thus the pending will not be reset next time, that's
+-- expected)
+
+UPDATE pendings SET pending_write = 0;
+
+--
+-- don't track every redeem, since update allocation or slow redeeming can
+-- break process; in such cases it requires workarounds; use pendings table
+-- to track pending redeems
+--
+
+DROP TABLE read_redeems CASCADE;
+DROP TABLE write_redeems CASCADE; -- with indices
+`,
+	},
+	{
+		Version: 10,
+		Name:    "add_time_unit_column_to_allocations",
+		Postgres: `
+--
+-- Add column time_unit to allocations. Default is 48h.
+--
+
+ALTER TABLE allocations
+    ADD COLUMN time_unit BIGINT NOT NULL DEFAULT 172800000000000;
+`,
+	},
+	{
+		Version: 11,
+		Name:    "add_payer_id_and_auth_tiket_columns_to_read_markers",
+		Postgres: `
+--
+-- add payer_id and auth_tiket columns to read_markers table
+--
+
+ALTER TABLE read_markers ADD COLUMN payer_id VARCHAR(64) NOT NULL;
+ALTER TABLE read_markers ADD COLUMN auth_ticket JSON;
+`,
+	},
+	{
+		Version: 12,
+		Name:    "add_attributes_column_to_reference_objects",
+		Postgres: `
+--
+-- Add who_pays column to reference_objects table.
+--
+
+ALTER TABLE reference_objects
+    ADD COLUMN attributes JSON DEFAULT '{}'::jsonb;
+`,
+	},
+	{
+		Version: 13,
+		Name:    "add_collaborators_table",
+		Postgres: `
+CREATE TABLE collaborators (
+    ref_id BIGSERIAL NOT NULL,
+    client_id VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+`,
+	},
+	{
+		Version: 14,
+		Name:    "increase_owner_pubkey",
+		Postgres: `
+--
+-- Increase the char limit of owner_public_key from 256 to 512.
+--
+
+ALTER TABLE allocations
+    ALTER COLUMN owner_public_key TYPE varchar(512);
+ALTER TABLE read_markers
+    ALTER COLUMN client_public_key TYPE varchar(512);
+ALTER TABLE write_markers
+    ALTER COLUMN client_key TYPE varchar(512);
+`,
+	},
+	{
+		Version: 15,
+		Name:    "add_reference_snapshots_table",
+		Postgres: `
+CREATE TABLE reference_snapshots (
+    id BIGSERIAL PRIMARY KEY,
+    allocation_id VARCHAR(64) NOT NULL,
+    allocation_root VARCHAR(64) NOT NULL,
+    content_hash VARCHAR(64) NOT NULL DEFAULT '',
+    thumbnail_hash VARCHAR(64) NOT NULL DEFAULT '',
+    ref TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_reference_snapshots_root ON reference_snapshots (allocation_id, allocation_root);
+CREATE INDEX idx_reference_snapshots_content ON reference_snapshots (content_hash);
+CREATE INDEX idx_reference_snapshots_thumbnail ON reference_snapshots (thumbnail_hash);
+`,
+		SQLite: `
+CREATE TABLE reference_snapshots (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    allocation_id VARCHAR(64) NOT NULL,
+    allocation_root VARCHAR(64) NOT NULL,
+    content_hash VARCHAR(64) NOT NULL DEFAULT '',
+    thumbnail_hash VARCHAR(64) NOT NULL DEFAULT '',
+    ref TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_reference_snapshots_root ON reference_snapshots (allocation_id, allocation_root);
+CREATE INDEX idx_reference_snapshots_content ON reference_snapshots (content_hash);
+CREATE INDEX idx_reference_snapshots_thumbnail ON reference_snapshots (thumbnail_hash);
+`,
+	},
+	{
+		Version: 16,
+		Name:    "add_file_versions_table",
+		Postgres: `
+ALTER TABLE allocations
+    ADD COLUMN max_file_versions INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN file_version_retention BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE file_versions (
+    id BIGSERIAL PRIMARY KEY,
+    allocation_id VARCHAR(64) NOT NULL,
+    ref_id BIGINT NOT NULL,
+    version BIGINT NOT NULL,
+    content_hash VARCHAR(64) NOT NULL,
+    merkle_root VARCHAR(64) NOT NULL DEFAULT '',
+    size BIGINT NOT NULL DEFAULT 0,
+    actual_file_hash VARCHAR(64) NOT NULL DEFAULT '',
+    actual_file_size BIGINT NOT NULL DEFAULT 0,
+    thumbnail_hash VARCHAR(64) NOT NULL DEFAULT '',
+    thumbnail_size BIGINT NOT NULL DEFAULT 0,
+    actual_thumbnail_hash VARCHAR(64) NOT NULL DEFAULT '',
+    actual_thumbnail_size BIGINT NOT NULL DEFAULT 0,
+    mimetype VARCHAR(64) NOT NULL DEFAULT '',
+    encrypted_key TEXT,
+    write_marker VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_file_versions_version ON file_versions (ref_id, version);
+CREATE INDEX idx_file_versions_allocation ON file_versions (allocation_id);
+CREATE INDEX idx_file_versions_content ON file_versions (content_hash);
+CREATE INDEX idx_file_versions_thumbnail ON file_versions (thumbnail_hash);
+`,
+		SQLite: `
+ALTER TABLE allocations ADD COLUMN max_file_versions INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE allocations ADD COLUMN file_version_retention BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE file_versions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    allocation_id VARCHAR(64) NOT NULL,
+    ref_id BIGINT NOT NULL,
+    version BIGINT NOT NULL,
+    content_hash VARCHAR(64) NOT NULL,
+    merkle_root VARCHAR(64) NOT NULL DEFAULT '',
+    size BIGINT NOT NULL DEFAULT 0,
+    actual_file_hash VARCHAR(64) NOT NULL DEFAULT '',
+    actual_file_size BIGINT NOT NULL DEFAULT 0,
+    thumbnail_hash VARCHAR(64) NOT NULL DEFAULT '',
+    thumbnail_size BIGINT NOT NULL DEFAULT 0,
+    actual_thumbnail_hash VARCHAR(64) NOT NULL DEFAULT '',
+    actual_thumbnail_size BIGINT NOT NULL DEFAULT 0,
+    mimetype VARCHAR(64) NOT NULL DEFAULT '',
+    encrypted_key TEXT,
+    write_marker VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_file_versions_version ON file_versions (ref_id, version);
+CREATE INDEX idx_file_versions_allocation ON file_versions (allocation_id);
+CREATE INDEX idx_file_versions_content ON file_versions (content_hash);
+CREATE INDEX idx_file_versions_thumbnail ON file_versions (thumbnail_hash);
+`,
+	},
+	{
+		Version: 17,
+		Name:    "add_reference_objects_deleted_at_index",
+		Postgres: `
+CREATE INDEX idx_reference_objects_deleted_at ON reference_objects (allocation_id, deleted_at);
+`,
+		SQLite: `
+CREATE INDEX idx_reference_objects_deleted_at ON reference_objects (allocation_id, deleted_at);
+`,
+	},
+	{
+		Version: 18,
+		Name:    "add_allocation_events_table",
+		Postgres: `
+CREATE TABLE allocation_events (
+    id BIGSERIAL PRIMARY KEY,
+    allocation_id VARCHAR(64) NOT NULL,
+    allocation_root VARCHAR(64) NOT NULL,
+    operation VARCHAR(20) NOT NULL,
+    path TEXT NOT NULL,
+    src_path TEXT NOT NULL DEFAULT '',
+    hash VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_allocation_events_allocation ON allocation_events (allocation_id, id);
+CREATE INDEX idx_allocation_events_root ON allocation_events (allocation_id, allocation_root);
+`,
+		SQLite: `
+CREATE TABLE allocation_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    allocation_id VARCHAR(64) NOT NULL,
+    allocation_root VARCHAR(64) NOT NULL,
+    operation VARCHAR(20) NOT NULL,
+    path TEXT NOT NULL,
+    src_path TEXT NOT NULL DEFAULT '',
+    hash VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_allocation_events_allocation ON allocation_events (allocation_id, id);
+CREATE INDEX idx_allocation_events_root ON allocation_events (allocation_id, allocation_root);
+`,
+	},
+	{
+		Version: 19,
+		Name:    "add_webhook_deliveries_table",
+		Postgres: `
+CREATE TABLE webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    url TEXT NOT NULL,
+    event VARCHAR(64) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    response_status INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at);
+CREATE INDEX idx_webhook_deliveries_created ON webhook_deliveries (created_at);
+`,
+		SQLite: `
+CREATE TABLE webhook_deliveries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    url TEXT NOT NULL,
+    event VARCHAR(64) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    response_status INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at);
+CREATE INDEX idx_webhook_deliveries_created ON webhook_deliveries (created_at);
+`,
+	},
+	{
+		Version: 20,
+		Name:    "add_scrub_results_table",
+		Postgres: `
+CREATE TABLE scrub_results (
+    ref_id BIGINT PRIMARY KEY,
+    allocation_id VARCHAR(64) NOT NULL,
+    path TEXT NOT NULL,
+    content_hash VARCHAR(64) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    scrubbed_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_scrub_results_status ON scrub_results (status, allocation_id);
+`,
+		SQLite: `
+CREATE TABLE scrub_results (
+    ref_id INTEGER PRIMARY KEY,
+    allocation_id VARCHAR(64) NOT NULL,
+    path TEXT NOT NULL,
+    content_hash VARCHAR(64) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    scrubbed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_scrub_results_status ON scrub_results (status, allocation_id);
+`,
+	},
+	{
+		Version: 21,
+		Name:    "add_challenges_created",
+		Postgres: `
+ALTER TABLE challenges ADD COLUMN created BIGINT NOT NULL DEFAULT 0;
+`,
+		SQLite: `
+ALTER TABLE challenges ADD COLUMN created BIGINT NOT NULL DEFAULT 0;
+`,
+	},
+	{
+		Version: 22,
+		Name:    "add_validator_stats_table",
+		Postgres: `
+CREATE TABLE validator_stats (
+    validator_id VARCHAR(64) PRIMARY KEY,
+    url TEXT NOT NULL,
+    responses BIGINT NOT NULL DEFAULT 0,
+    failures BIGINT NOT NULL DEFAULT 0,
+    decayed_responses DOUBLE PRECISION NOT NULL DEFAULT 0,
+    decayed_failures DOUBLE PRECISION NOT NULL DEFAULT 0,
+    total_latency_ms BIGINT NOT NULL DEFAULT 0,
+    last_response_at TIMESTAMP,
+    last_failure_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+`,
+		SQLite: `
+CREATE TABLE validator_stats (
+    validator_id VARCHAR(64) PRIMARY KEY,
+    url TEXT NOT NULL,
+    responses BIGINT NOT NULL DEFAULT 0,
+    failures BIGINT NOT NULL DEFAULT 0,
+    decayed_responses REAL NOT NULL DEFAULT 0,
+    decayed_failures REAL NOT NULL DEFAULT 0,
+    total_latency_ms BIGINT NOT NULL DEFAULT 0,
+    last_response_at TIMESTAMP,
+    last_failure_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+`,
+	},
+	{
+		Version: 23,
+		Name:    "add_reference_objects_last_version",
+		Postgres: `
+ALTER TABLE reference_objects ADD COLUMN last_version BIGINT NOT NULL DEFAULT 0;
+UPDATE reference_objects SET last_version = (
+    SELECT COALESCE(MAX(version), 0) FROM file_versions
+    WHERE file_versions.ref_id = reference_objects.id);
+`,
+		SQLite: `
+ALTER TABLE reference_objects ADD COLUMN last_version BIGINT NOT NULL DEFAULT 0;
+UPDATE reference_objects SET last_version = (
+    SELECT COALESCE(MAX(version), 0) FROM file_versions
+    WHERE file_versions.ref_id = reference_objects.id);
+`,
+	},
+}
@@ -2,11 +2,15 @@ package datastore
 
 import (
 	"database/sql"
+	"testing"
+
+	"0chain.net/core/logging"
+
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"testing"
 )
 
 func MockTheStore(t *testing.T) sqlmock.Sqlmock {
@@ -30,3 +34,17 @@ func MockTheStore(t *testing.T) sqlmock.Sqlmock {
 
 	return mock
 }
+
+// OpenTheSQLiteStore replaces the store with a new in-memory SQLite database
+// holding the blobber schema, for tests running real queries. The caller
+// closes it with GetStore().Close().
+func OpenTheSQLiteStore(t *testing.T) {
+	if logging.Logger == nil {
+		logging.Logger = zap.NewNop()
+	}
+	var s Store
+	require.NoError(t, s.openSQLite(":memory:"))
+	_, err := s.Migrate()
+	require.NoError(t, err)
+	setDB(s.db)
+}
@@ -0,0 +1,245 @@
+package datastore
+
+// sqliteSchema is the SQLite dialect of the schema of version 14, created by
+// the first migration.
+//
+// The updated_at triggers mirror update_modified_column, and the sequence
+// triggers number the write markers and the challenges as the BIGSERIAL
+// sequence columns do.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS allocations (
+    id VARCHAR(64) PRIMARY KEY,
+    tx VARCHAR(64) NOT NULL,
+    size BIGINT NOT NULL DEFAULT 0,
+    used_size BIGINT NOT NULL DEFAULT 0,
+    owner_id VARCHAR(64) NOT NULL,
+    owner_public_key VARCHAR(512) NOT NULL,
+    payer_id VARCHAR(64) NOT NULL,
+    expiration_date BIGINT NOT NULL,
+    allocation_root VARCHAR(255) NOT NULL DEFAULT '',
+    blobber_size BIGINT NOT NULL DEFAULT 0,
+    blobber_size_used BIGINT NOT NULL DEFAULT 0,
+    latest_redeemed_write_marker VARCHAR(255),
+    is_redeem_required BOOLEAN,
+    time_unit BIGINT NOT NULL DEFAULT 172800000000000,
+    cleaned_up BOOLEAN NOT NULL DEFAULT FALSE,
+    finalized BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_allocations_tx ON allocations (tx);
+
+CREATE TABLE IF NOT EXISTS allocation_connections (
+    connection_id VARCHAR(64) PRIMARY KEY,
+    allocation_id VARCHAR(64) NOT NULL,
+    client_id VARCHAR(64) NOT NULL,
+    size BIGINT NOT NULL DEFAULT 0,
+    status INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS allocation_changes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    connection_id VARCHAR(64) REFERENCES allocation_connections (connection_id),
+    operation VARCHAR(64) NOT NULL,
+    size BIGINT NOT NULL DEFAULT 0,
+    input TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS reference_objects (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    lookup_hash VARCHAR(64) NOT NULL,
+    path_hash VARCHAR(64) NOT NULL,
+    type VARCHAR(10) NOT NULL,
+    allocation_id VARCHAR(64) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    path TEXT NOT NULL,
+    hash VARCHAR(64) NOT NULL,
+    num_of_blocks BIGINT NOT NULL DEFAULT 0,
+    parent_path TEXT,
+    level INT NOT NULL DEFAULT 0,
+    custom_meta TEXT NOT NULL,
+    content_hash VARCHAR(64) NOT NULL,
+    size BIGINT NOT NULL DEFAULT 0,
+    merkle_root VARCHAR(64) NOT NULL,
+    actual_file_size BIGINT NOT NULL DEFAULT 0,
+    actual_file_hash VARCHAR(64) NOT NULL,
+    mimetype VARCHAR(64) NOT NULL,
+    write_marker VARCHAR(64) NOT NULL,
+    thumbnail_hash VARCHAR(64) NOT NULL,
+    thumbnail_size BIGINT NOT NULL DEFAULT 0,
+    actual_thumbnail_size BIGINT NOT NULL DEFAULT 0,
+    actual_thumbnail_hash VARCHAR(64) NOT NULL,
+    encrypted_key TEXT,
+    attributes JSON DEFAULT '{}',
+    on_cloud BOOLEAN DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS write_markers (
+    allocation_root VARCHAR(64) PRIMARY KEY,
+    prev_allocation_root VARCHAR(64) NOT NULL,
+    allocation_id VARCHAR(64) NOT NULL,
+    client_id VARCHAR(64) NOT NULL,
+    blobber_id VARCHAR(64) NOT NULL,
+    size BIGINT NOT NULL DEFAULT 0,
+    timestamp BIGINT NOT NULL,
+    signature VARCHAR(256) NOT NULL,
+    status INT NOT NULL DEFAULT 0,
+    status_message TEXT,
+    redeem_retries INT NOT NULL DEFAULT 0,
+    close_txn_id VARCHAR(64),
+    connection_id VARCHAR(64) NOT NULL,
+    client_key VARCHAR(512) NOT NULL,
+    sequence BIGINT UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS read_markers (
+    client_id VARCHAR(64) NOT NULL PRIMARY KEY,
+    client_public_key VARCHAR(512) NOT NULL,
+    blobber_id VARCHAR(64) NOT NULL,
+    allocation_id VARCHAR(64) NOT NULL,
+    owner_id VARCHAR(64) NOT NULL,
+    payer_id VARCHAR(64) NOT NULL,
+    auth_ticket JSON,
+    timestamp BIGINT NOT NULL,
+    counter BIGINT NOT NULL DEFAULT 0,
+    suspend BIGINT NOT NULL DEFAULT -1,
+    signature VARCHAR(256) NOT NULL,
+    latest_redeemed_rm JSON,
+    redeem_required BOOLEAN,
+    latest_redeem_txn_id VARCHAR(64),
+    status_message TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS challenges (
+    challenge_id VARCHAR(64) NOT NULL PRIMARY KEY,
+    prev_challenge_id VARCHAR(64),
+    seed BIGINT NOT NULL DEFAULT 0,
+    allocation_id VARCHAR(64) NOT NULL,
+    allocation_root VARCHAR(255),
+    responded_allocation_root VARCHAR(255),
+    status INT NOT NULL DEFAULT 0,
+    result INT NOT NULL DEFAULT 0,
+    status_message TEXT,
+    commit_txn_id VARCHAR(64),
+    block_num BIGINT,
+    ref_id BIGINT,
+    validation_tickets JSON,
+    validators JSON,
+    last_commit_txn_ids JSON,
+    object_path JSON,
+    sequence BIGINT UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS file_stats (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    ref_id BIGINT UNIQUE REFERENCES reference_objects (id),
+    num_of_updates BIGINT,
+    num_of_block_downloads BIGINT,
+    num_of_challenges BIGINT,
+    num_of_failed_challenges BIGINT,
+    last_challenge_txn VARCHAR(64),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS terms (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    blobber_id VARCHAR(64) NOT NULL,
+    allocation_id VARCHAR(64) REFERENCES allocations (id),
+    read_price BIGINT NOT NULL,
+    write_price BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS pendings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    client_id VARCHAR(64) NOT NULL,
+    allocation_id VARCHAR(64) NOT NULL,
+    blobber_id VARCHAR(64) NOT NULL,
+    pending_write BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pendings_cab
+    ON pendings (client_id, allocation_id, blobber_id);
+
+CREATE TABLE IF NOT EXISTS read_pools (
+    pool_id TEXT NOT NULL PRIMARY KEY,
+    client_id VARCHAR(64) NOT NULL,
+    blobber_id VARCHAR(64) NOT NULL,
+    allocation_id VARCHAR(64) NOT NULL,
+    balance BIGINT NOT NULL,
+    expire_at BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_read_pools_cab
+    ON read_pools (client_id, allocation_id, blobber_id);
+
+CREATE TABLE IF NOT EXISTS write_pools (
+    pool_id TEXT NOT NULL PRIMARY KEY,
+    client_id VARCHAR(64) NOT NULL,
+    blobber_id VARCHAR(64) NOT NULL,
+    allocation_id VARCHAR(64) NOT NULL,
+    balance BIGINT NOT NULL,
+    expire_at BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_write_pools_cab
+    ON write_pools (client_id, allocation_id, blobber_id);
+
+CREATE TABLE IF NOT EXISTS commit_meta_txns (
+    ref_id BIGINT NOT NULL,
+    txn_id VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS collaborators (
+    ref_id BIGINT NOT NULL,
+    client_id VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER IF NOT EXISTS allocation_modtime AFTER UPDATE ON allocations FOR EACH ROW
+BEGIN UPDATE allocations SET updated_at = CURRENT_TIMESTAMP WHERE rowid = NEW.rowid; END;
+
+CREATE TRIGGER IF NOT EXISTS allocation_connections_modtime AFTER UPDATE ON allocation_connections FOR EACH ROW
+BEGIN UPDATE allocation_connections SET updated_at = CURRENT_TIMESTAMP WHERE rowid = NEW.rowid; END;
+
+CREATE TRIGGER IF NOT EXISTS allocation_changes_modtime AFTER UPDATE ON allocation_changes FOR EACH ROW
+BEGIN UPDATE allocation_changes SET updated_at = CURRENT_TIMESTAMP WHERE rowid = NEW.rowid; END;
+
+CREATE TRIGGER IF NOT EXISTS reference_objects_modtime AFTER UPDATE ON reference_objects FOR EACH ROW
+BEGIN UPDATE reference_objects SET updated_at = CURRENT_TIMESTAMP WHERE rowid = NEW.rowid; END;
+
+CREATE TRIGGER IF NOT EXISTS write_markers_modtime AFTER UPDATE ON write_markers FOR EACH ROW
+BEGIN UPDATE write_markers SET updated_at = CURRENT_TIMESTAMP WHERE rowid = NEW.rowid; END;
+
+CREATE TRIGGER IF NOT EXISTS read_markers_modtime AFTER UPDATE ON read_markers FOR EACH ROW
+BEGIN UPDATE read_markers SET updated_at = CURRENT_TIMESTAMP WHERE rowid = NEW.rowid; END;
+
+CREATE TRIGGER IF NOT EXISTS challenges_modtime AFTER UPDATE ON challenges FOR EACH ROW
+BEGIN UPDATE challenges SET updated_at = CURRENT_TIMESTAMP WHERE rowid = NEW.rowid; END;
+
+CREATE TRIGGER IF NOT EXISTS file_stats_modtime AFTER UPDATE ON file_stats FOR EACH ROW
+BEGIN UPDATE file_stats SET updated_at = CURRENT_TIMESTAMP WHERE rowid = NEW.rowid; END;
+
+CREATE TRIGGER IF NOT EXISTS write_markers_sequence AFTER INSERT ON write_markers FOR EACH ROW
+WHEN NEW.sequence IS NULL
+BEGIN UPDATE write_markers SET sequence = (SELECT IFNULL(MAX(sequence), 0) + 1 FROM write_markers) WHERE rowid = NEW.rowid; END;
+
+CREATE TRIGGER IF NOT EXISTS challenges_sequence AFTER INSERT ON challenges FOR EACH ROW
+WHEN NEW.sequence IS NULL
+BEGIN UPDATE challenges SET sequence = (SELECT IFNULL(MAX(sequence), 0) + 1 FROM challenges) WHERE rowid = NEW.rowid; END;
+`
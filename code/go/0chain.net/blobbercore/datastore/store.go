@@ -3,12 +3,14 @@ package datastore
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"0chain.net/blobbercore/config"
 	"0chain.net/blobbercore/errors"
 
 	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 
 	. "0chain.net/core/logging"
@@ -16,7 +18,31 @@ import (
 
 type contextKey int
 
-const CONNECTION_CONTEXT_KEY contextKey = iota
+const (
+	CONNECTION_CONTEXT_KEY contextKey = iota
+	commitHooksContextKey
+)
+
+// commitHooks are the functions run once a transaction is committed, or
+// rolled back.
+type commitHooks struct {
+	mu        sync.Mutex
+	hooks     []func()
+	rollbacks []func()
+}
+
+// take returns the hooks of the commit, or the ones of the rollback, and
+// drops them all.
+func (ch *commitHooks) take(committed bool) []func() {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+	hooks := ch.rollbacks
+	if committed {
+		hooks = ch.hooks
+	}
+	ch.hooks, ch.rollbacks = nil, nil
+	return hooks
+}
 
 type Store struct {
 	db *gorm.DB
@@ -32,7 +58,25 @@ func GetStore() *Store {
 	return &store
 }
 
+const (
+	PostgresDriver = "postgres"
+	SQLiteDriver   = "sqlite"
+)
+
+// Open connects to the metadata database of the configured driver. The
+// schema is created and upgraded by Migrate.
 func (store *Store) Open() error {
+	switch config.Configuration.DBDriver {
+	case "", PostgresDriver:
+		return store.openPostgres()
+	case SQLiteDriver:
+		return store.openSQLite(config.Configuration.DBPath)
+	default:
+		return errors.InvalidDBDriverError
+	}
+}
+
+func (store *Store) openPostgres() error {
 	db, err := gorm.Open(postgres.Open(fmt.Sprintf(
 		"host=%v port=%v user=%v dbname=%v password=%v sslmode=disable",
 		config.Configuration.DBHost, config.Configuration.DBPort,
@@ -56,6 +100,39 @@ func (store *Store) Open() error {
 	return nil
 }
 
+// openSQLite opens the database file at the given path, or a private
+// in-memory database for ":memory:".
+func (store *Store) openSQLite(path string) error {
+	var inMemory = path == "" || path == ":memory:"
+	var dsn = path
+	if inMemory {
+		dsn = ":memory:"
+	}
+	// transactions take the write lock upfront, concurrent ones wait for it
+	// instead of failing on upgrade
+	dsn += "?_foreign_keys=1&_busy_timeout=10000&_txlock=immediate"
+	if !inMemory {
+		dsn += "&_journal_mode=WAL"
+	}
+
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return errors.DBOpenError
+	}
+
+	sqldb, err := db.DB()
+	if err != nil {
+		return errors.DBOpenError
+	}
+	if inMemory {
+		// every connection would get its own empty database
+		sqldb.SetMaxOpenConns(1)
+	}
+
+	store.db = db
+	return nil
+}
+
 func (store *Store) Close() {
 	if store.db != nil {
 		if sqldb, _ := store.db.DB(); sqldb != nil {
@@ -66,7 +143,61 @@ func (store *Store) Close() {
 
 func (store *Store) CreateTransaction(ctx context.Context) context.Context {
 	db := store.db.Begin()
-	return context.WithValue(ctx, CONNECTION_CONTEXT_KEY, db) //nolint:staticcheck // changing type might require further refactor
+	ctx = context.WithValue(ctx, CONNECTION_CONTEXT_KEY, db) //nolint:staticcheck // changing type might require further refactor
+	return context.WithValue(ctx, commitHooksContextKey, new(commitHooks))
+}
+
+// OnCommit registers f to run once the transaction of the context is
+// committed with Commit. It never runs if the transaction is rolled back.
+func (store *Store) OnCommit(ctx context.Context, f func()) {
+	ch, ok := ctx.Value(commitHooksContextKey).(*commitHooks)
+	if !ok {
+		Logger.Error("No transaction in the context for the commit hook.")
+		return
+	}
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+	ch.hooks = append(ch.hooks, f)
+}
+
+// OnRollback registers f to run once the transaction of the context is
+// rolled back with Rollback, or fails to commit with Commit.
+func (store *Store) OnRollback(ctx context.Context, f func()) {
+	ch, ok := ctx.Value(commitHooksContextKey).(*commitHooks)
+	if !ok {
+		Logger.Error("No transaction in the context for the rollback hook.")
+		return
+	}
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+	ch.rollbacks = append(ch.rollbacks, f)
+}
+
+// Commit commits the transaction of the context, then runs the functions
+// registered with OnCommit, or the ones registered with OnRollback if it
+// fails.
+func (store *Store) Commit(ctx context.Context) error {
+	err := store.GetTransaction(ctx).Commit().Error
+	runHooks(ctx, err == nil)
+	return err
+}
+
+// Rollback rolls the transaction of the context back, then runs the
+// functions registered with OnRollback.
+func (store *Store) Rollback(ctx context.Context) error {
+	err := store.GetTransaction(ctx).Rollback().Error
+	runHooks(ctx, false)
+	return err
+}
+
+func runHooks(ctx context.Context, committed bool) {
+	ch, ok := ctx.Value(commitHooksContextKey).(*commitHooks)
+	if !ok {
+		return
+	}
+	for _, f := range ch.take(committed) {
+		f()
+	}
 }
 
 func (store *Store) GetTransaction(ctx context.Context) *gorm.DB {
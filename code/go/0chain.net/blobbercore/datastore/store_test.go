@@ -0,0 +1,68 @@
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_OpenInvalidDriver(t *testing.T) {
+	config.Configuration.DBDriver = "mysql"
+	defer func() { config.Configuration.DBDriver = "" }()
+
+	var s Store
+	assert.Equal(t, errors.InvalidDBDriverError, s.Open())
+}
+
+func TestStore_SQLiteSequence(t *testing.T) {
+	OpenTheSQLiteStore(t)
+	defer GetStore().Close()
+
+	ctx := GetStore().CreateTransaction(context.Background())
+	tx := GetStore().GetTransaction(ctx)
+	for _, root := range []string{"root1", "root2"} {
+		err := tx.Exec(`INSERT INTO write_markers (allocation_root,
+			prev_allocation_root, allocation_id, client_id, blobber_id,
+			timestamp, signature, connection_id, client_key)
+			VALUES (?, '', 'alloc', 'client', 'blobber', 1, 'sig', 'conn', 'key')`,
+			root).Error
+		require.NoError(t, err)
+	}
+	require.NoError(t, tx.Commit().Error)
+
+	var seq []int64
+	err := GetStore().GetDB().Table("write_markers").Order("sequence").
+		Pluck("sequence", &seq).Error
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, seq)
+}
+
+func TestStore_TransactionHooks(t *testing.T) {
+	OpenTheSQLiteStore(t)
+	defer GetStore().Close()
+
+	var committed, rolledBack int
+	ctx := GetStore().CreateTransaction(context.Background())
+	GetStore().OnCommit(ctx, func() { committed++ })
+	GetStore().OnRollback(ctx, func() { rolledBack++ })
+	require.NoError(t, GetStore().Commit(ctx))
+	assert.Equal(t, 1, committed)
+	assert.Equal(t, 0, rolledBack)
+
+	ctx = GetStore().CreateTransaction(context.Background())
+	GetStore().OnCommit(ctx, func() { committed++ })
+	GetStore().OnRollback(ctx, func() { rolledBack++ })
+	require.NoError(t, GetStore().Rollback(ctx))
+	assert.Equal(t, 1, committed)
+	assert.Equal(t, 1, rolledBack)
+
+	// the hooks run once, the transaction being done
+	assert.Error(t, GetStore().Commit(ctx))
+	assert.Equal(t, 1, committed)
+	assert.Equal(t, 1, rolledBack)
+}
@@ -7,4 +7,6 @@ import (
 var (
 	//DBOpenError - Error opening the db
 	DBOpenError = common.NewError("db_open_error", "Error opening the DB connection")
+	//InvalidDBDriverError - Unknown db driver configured
+	InvalidDBDriverError = common.NewError("invalid_db_driver", "Unknown DB driver, use postgres or sqlite")
 )
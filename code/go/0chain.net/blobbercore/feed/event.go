@@ -0,0 +1,91 @@
+// Package feed keeps the changes committed to the allocations as an ordered
+// feed of events, which clients watch to learn about the changes as they are
+// committed.
+package feed
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+
+	"gorm.io/gorm"
+)
+
+// Event is a change committed to an allocation. The events of an allocation
+// are ordered by id, the order of the commits and of the changes of a commit.
+type Event struct {
+	ID           int64  `gorm:"column:id;primary_key" json:"id"`
+	AllocationID string `gorm:"column:allocation_id" json:"allocation_id"`
+	// AllocationRoot is the one of the write marker of the commit.
+	AllocationRoot string `gorm:"column:allocation_root" json:"allocation_root"`
+	Operation      string `gorm:"column:operation" json:"operation"`
+	Path           string `gorm:"column:path" json:"path"`
+	// SrcPath is the path the object is renamed, moved or copied from.
+	SrcPath string `gorm:"column:src_path" json:"src_path,omitempty"`
+	// Hash is the hash of the object at the path as of the commit, empty
+	// once deleted.
+	Hash      string    `gorm:"column:hash" json:"hash"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (Event) TableName() string {
+	return "allocation_events"
+}
+
+// AddEvents adds the events of a commit, which are sent to the watchers of
+// the allocation once the transaction of the context is committed.
+func AddEvents(ctx context.Context, allocationID string, events []*Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	db := datastore.GetStore().GetTransaction(ctx)
+	for _, event := range events {
+		event.AllocationID = allocationID
+		if err := db.Create(event).Error; err != nil {
+			return err
+		}
+	}
+	datastore.GetStore().OnCommit(ctx, func() {
+		notify(allocationID)
+	})
+	return nil
+}
+
+// GetEvents returns the events of the allocation after the one of the id, at
+// most limit of them.
+func GetEvents(ctx context.Context, allocationID string, afterID int64, limit int) ([]*Event, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var events []*Event
+	err := db.Where("allocation_id = ? AND id > ?", allocationID, afterID).
+		Order("id").Limit(limit).Find(&events).Error
+	return events, err
+}
+
+// LastEventID returns the id of the last event of the allocation, zero if
+// there is none.
+func LastEventID(ctx context.Context, allocationID string) (int64, error) {
+	return lastEventID(datastore.GetStore().GetTransaction(ctx).
+		Where("allocation_id = ?", allocationID))
+}
+
+// LastEventIDOfRoot returns the id of the last event of the commit of the
+// allocation root, gorm.ErrRecordNotFound if the root has no events.
+func LastEventIDOfRoot(ctx context.Context, allocationID, allocationRoot string) (int64, error) {
+	id, err := lastEventID(datastore.GetStore().GetTransaction(ctx).
+		Where("allocation_id = ? AND allocation_root = ?", allocationID, allocationRoot))
+	if err == nil && id == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+	return id, err
+}
+
+func lastEventID(db *gorm.DB) (int64, error) {
+	var event Event
+	err := db.Select("id").Order("id DESC").First(&event).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	return event.ID, err
+}
@@ -0,0 +1,108 @@
+package feed
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+)
+
+const (
+	// watchBatch is the number of events read at once by the watchers.
+	watchBatch = 100
+	// KeepAliveInterval is the interval at which the watchers are told to
+	// keep their connections alive, well under the usual idle timeouts of
+	// the proxies.
+	KeepAliveInterval = 15 * time.Second
+)
+
+var (
+	watchersMu sync.Mutex
+	watchers   = make(map[string]map[chan struct{}]struct{})
+)
+
+// subscribe returns a channel signaled when events are committed for the
+// allocation, and the function removing it.
+func subscribe(allocationID string) (chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	watchersMu.Lock()
+	if watchers[allocationID] == nil {
+		watchers[allocationID] = make(map[chan struct{}]struct{})
+	}
+	watchers[allocationID][ch] = struct{}{}
+	watchersMu.Unlock()
+
+	return ch, func() {
+		watchersMu.Lock()
+		delete(watchers[allocationID], ch)
+		if len(watchers[allocationID]) == 0 {
+			delete(watchers, allocationID)
+		}
+		watchersMu.Unlock()
+	}
+}
+
+func notify(allocationID string) {
+	watchersMu.Lock()
+	defer watchersMu.Unlock()
+	for ch := range watchers[allocationID] {
+		// a pending signal already makes the watcher read the new events
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Watch sends the events of the allocation after the one of the id, then
+// the ones committed later, as they are, until the context is done or send
+// fails. keepAlive, if set, is called every KeepAliveInterval to keep the
+// connection of the watcher alive. The events are read with transactions of
+// their own, the one of the context, if any, is not used.
+func Watch(ctx context.Context, allocationID string, afterID int64,
+	send func(*Event) error, keepAlive func() error) error {
+
+	signal, unsubscribe := subscribe(allocationID)
+	defer unsubscribe()
+
+	ticker := time.NewTicker(KeepAliveInterval)
+	defer ticker.Stop()
+	for {
+		events, err := readEvents(ctx, allocationID, afterID)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err = send(event); err != nil {
+				return err
+			}
+			afterID = event.ID
+		}
+		if len(events) == watchBatch {
+			continue
+		}
+
+		for waiting := true; waiting; {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-signal:
+				waiting = false
+			case <-ticker.C:
+				if keepAlive == nil {
+					continue
+				}
+				if err = keepAlive(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func readEvents(ctx context.Context, allocationID string, afterID int64) ([]*Event, error) {
+	ctx = datastore.GetStore().CreateTransaction(ctx)
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+	return GetEvents(ctx, allocationID, afterID, watchBatch)
+}
@@ -0,0 +1,89 @@
+package feed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+const feedAllocationID = "feed_alloc"
+
+func commitEvents(t *testing.T, allocationRoot string, paths ...string) {
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	var events []*Event
+	for _, path := range paths {
+		events = append(events, &Event{AllocationRoot: allocationRoot, Operation: "insert", Path: path})
+	}
+	require.NoError(t, AddEvents(ctx, feedAllocationID, events))
+	require.NoError(t, datastore.GetStore().Commit(ctx))
+}
+
+func receive(t *testing.T, events chan *Event) *Event {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no event received")
+		return nil
+	}
+}
+
+func TestLastEventIDOfRoot(t *testing.T) {
+	datastore.OpenTheSQLiteStore(t)
+	defer datastore.GetStore().Close()
+	commitEvents(t, "root1", "/a", "/b")
+	commitEvents(t, "root2", "/c")
+
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+	id, err := LastEventIDOfRoot(ctx, feedAllocationID, "root1")
+	require.NoError(t, err)
+	events, err := GetEvents(ctx, feedAllocationID, id, watchBatch)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "/c", events[0].Path)
+
+	last, err := LastEventID(ctx, feedAllocationID)
+	require.NoError(t, err)
+	assert.Equal(t, events[0].ID, last)
+
+	_, err = LastEventIDOfRoot(ctx, feedAllocationID, "unknown")
+	assert.Equal(t, gorm.ErrRecordNotFound, err)
+}
+
+func TestWatch(t *testing.T) {
+	datastore.OpenTheSQLiteStore(t)
+	defer datastore.GetStore().Close()
+	commitEvents(t, "root1", "/a", "/b")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	received := make(chan *Event, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- Watch(ctx, feedAllocationID, 1, func(event *Event) error {
+			received <- event
+			return nil
+		}, nil)
+	}()
+
+	event := receive(t, received)
+	assert.Equal(t, "/b", event.Path)
+	assert.Equal(t, feedAllocationID, event.AllocationID)
+
+	// committed while watched
+	commitEvents(t, "root2", "/c", "/d")
+	assert.Equal(t, "/c", receive(t, received).Path)
+	event = receive(t, received)
+	assert.Equal(t, "/d", event.Path)
+	assert.Equal(t, "root2", event.AllocationRoot)
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.Empty(t, watchers)
+}
@@ -0,0 +1,281 @@
+package filestore
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// CompressedObjectExt is appended to the object path of compressed objects.
+//
+// A compressed object keeps every CHUNK_SIZE block of the content in its own
+// zstd frame, so that blocks can be read without decompressing the whole
+// object. The frames are followed by an index of the frame sizes and a
+// trailer:
+//
+//	frame 0 | ... | frame n-1 | n x uint32 frame size | uint64 content size | uint32 n | magic
+const CompressedObjectExt = ".zst"
+
+const (
+	compressedMagic       = "0czs"
+	compressedTrailerSize = 8 + 4 + len(compressedMagic)
+)
+
+var errInvalidCompressedObject = errors.New("invalid compressed object")
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+)
+
+func zstdCodecs() (*zstd.Encoder, *zstd.Decoder) {
+	zstdOnce.Do(func() {
+		// errors are only returned for invalid options
+		zstdEncoder, _ = zstd.NewWriter(nil)
+		zstdDecoder, _ = zstd.NewReader(nil)
+	})
+	return zstdEncoder, zstdDecoder
+}
+
+// isCompressible tells whether content of the mime type is compressed, given
+// the configured mime type prefixes.
+func isCompressible(mimeType string, prefixes []string) bool {
+	if mimeType == "" {
+		return false
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(mimeType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// compressFile writes the compressed form of src to dst. Compression is
+// given up, leaving no dst file, if it saves less than a tenth of the size;
+// the returned flag tells whether dst was written.
+func compressFile(src, dst string) (bool, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return false, err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return false, err
+	}
+	keep := false
+	defer func() {
+		out.Close()
+		if !keep {
+			os.Remove(dst)
+		}
+	}()
+
+	var (
+		encoder, _ = zstdCodecs()
+		writer     = bufio.NewWriter(out)
+		block      = make([]byte, CHUNK_SIZE)
+		frame      []byte
+		frameSizes []uint32
+		size       int64
+		compressed int64
+	)
+	for {
+		n, err := io.ReadFull(in, block)
+		if n > 0 {
+			frame = encoder.EncodeAll(block[:n], frame[:0])
+			if _, err := writer.Write(frame); err != nil {
+				return false, err
+			}
+			frameSizes = append(frameSizes, uint32(len(frame)))
+			size += int64(n)
+			compressed += int64(len(frame))
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if compressed*10 > size*9 {
+		return false, nil
+	}
+
+	index := make([]byte, 4*len(frameSizes)+compressedTrailerSize)
+	for i, frameSize := range frameSizes {
+		binary.BigEndian.PutUint32(index[4*i:], frameSize)
+	}
+	trailer := index[4*len(frameSizes):]
+	binary.BigEndian.PutUint64(trailer, uint64(size))
+	binary.BigEndian.PutUint32(trailer[8:], uint32(len(frameSizes)))
+	copy(trailer[12:], compressedMagic)
+	if _, err := writer.Write(index); err != nil {
+		return false, err
+	}
+	if err := writer.Flush(); err != nil {
+		return false, err
+	}
+	if err := out.Sync(); err != nil {
+		return false, err
+	}
+	keep = true
+	return true, nil
+}
+
+// compressedReader gives random access to the decompressed content of a
+// compressed object.
+type compressedReader struct {
+	file    *os.File
+	size    int64
+	offsets []int64 // of the frames in the file, plus the index offset
+
+	pos        int64
+	cached     int // the block held in block, -1 for none
+	block      []byte
+	frameBytes []byte
+}
+
+// compressedContentSize reads the content size from the trailer of a
+// compressed object.
+func compressedContentSize(file *os.File) (int64, uint32, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	if info.Size() < int64(compressedTrailerSize) {
+		return 0, 0, errInvalidCompressedObject
+	}
+	trailer := make([]byte, compressedTrailerSize)
+	if _, err := file.ReadAt(trailer, info.Size()-int64(compressedTrailerSize)); err != nil {
+		return 0, 0, err
+	}
+	if string(trailer[12:]) != compressedMagic {
+		return 0, 0, errInvalidCompressedObject
+	}
+	return int64(binary.BigEndian.Uint64(trailer)), binary.BigEndian.Uint32(trailer[8:]), nil
+}
+
+func newCompressedReader(file *os.File) (*compressedReader, error) {
+	size, numFrames, err := compressedContentSize(file)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	indexOffset := info.Size() - int64(compressedTrailerSize) - 4*int64(numFrames)
+	if indexOffset < 0 {
+		return nil, errInvalidCompressedObject
+	}
+	index := make([]byte, 4*numFrames)
+	if _, err := file.ReadAt(index, indexOffset); err != nil {
+		return nil, err
+	}
+
+	offsets := make([]int64, numFrames+1)
+	for i := 0; i < int(numFrames); i++ {
+		offsets[i+1] = offsets[i] + int64(binary.BigEndian.Uint32(index[4*i:]))
+	}
+	if offsets[numFrames] != indexOffset {
+		return nil, errInvalidCompressedObject
+	}
+
+	return &compressedReader{
+		file:    file,
+		size:    size,
+		offsets: offsets,
+		cached:  -1,
+	}, nil
+}
+
+func (cr *compressedReader) loadBlock(i int) error {
+	if cr.cached == i {
+		return nil
+	}
+	frameSize := cr.offsets[i+1] - cr.offsets[i]
+	if int64(cap(cr.frameBytes)) < frameSize {
+		cr.frameBytes = make([]byte, frameSize)
+	}
+	cr.frameBytes = cr.frameBytes[:frameSize]
+	if _, err := cr.file.ReadAt(cr.frameBytes, cr.offsets[i]); err != nil {
+		return err
+	}
+	_, decoder := zstdCodecs()
+	block, err := decoder.DecodeAll(cr.frameBytes, cr.block[:0])
+	if err != nil {
+		cr.cached = -1
+		return err
+	}
+	cr.block = block
+	cr.cached = i
+	return nil
+}
+
+func (cr *compressedReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("negative offset")
+	}
+	var n int
+	for n < len(p) {
+		if off >= cr.size {
+			return n, io.EOF
+		}
+		i := int(off / CHUNK_SIZE)
+		if err := cr.loadBlock(i); err != nil {
+			return n, err
+		}
+		start := off - int64(i)*CHUNK_SIZE
+		if start >= int64(len(cr.block)) {
+			return n, errInvalidCompressedObject
+		}
+		copied := copy(p[n:], cr.block[start:])
+		n += copied
+		off += int64(copied)
+	}
+	return n, nil
+}
+
+func (cr *compressedReader) Read(p []byte) (int, error) {
+	if cr.pos >= cr.size {
+		return 0, io.EOF
+	}
+	n, err := cr.ReadAt(p, cr.pos)
+	cr.pos += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+func (cr *compressedReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += cr.pos
+	case io.SeekEnd:
+		offset += cr.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	cr.pos = offset
+	return offset, nil
+}
+
+func (cr *compressedReader) Close() error {
+	return cr.file.Close()
+}
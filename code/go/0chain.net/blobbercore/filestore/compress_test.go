@@ -0,0 +1,133 @@
+package filestore
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"0chain.net/blobbercore/internal/filestoretest"
+
+	"github.com/minio/minio-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileFSStore_Compression(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	const allocationID = "4f928c7857fabb5737347c42204eea919a4777f893f35724f563b932f64e2367"
+	var (
+		fs      = &FileFSStore{RootDirectory: dir, CompressMimeTypes: []string{"text/"}}
+		content = bytes.Repeat([]byte("a very compressible line of text\n"), 10000)
+		input   = &FileInputData{Name: "notes.txt", Path: "/notes.txt", MimeType: "text/plain"}
+	)
+
+	output, err := fs.WriteFile(allocationID, input, filestoretest.NewMemFile(content), "connection")
+	require.NoError(t, err)
+	input.Hash = output.ContentHash
+	_, err = fs.CommitWrite(allocationID, input, "connection")
+	require.NoError(t, err)
+
+	allocation, err := fs.SetupAllocation(allocationID, true)
+	require.NoError(t, err)
+	objectPath := fs.objectPath(allocation, output.ContentHash)
+	assert.Equal(t, CompressedObjectExt, filepath.Ext(objectPath))
+
+	// blocks and merkle proofs are the same as for the plain content
+	for blockNum := int64(1); blockNum <= 6; blockNum++ {
+		block, err := fs.GetFileBlock(allocationID, input, blockNum, 1)
+		require.NoError(t, err)
+		end := blockNum * CHUNK_SIZE
+		if end > int64(len(content)) {
+			end = int64(len(content))
+		}
+		assert.Equal(t, content[(blockNum-1)*CHUNK_SIZE:end], block)
+	}
+	_, mt, err := fs.GetFileBlockForChallenge(allocationID, input, 5)
+	require.NoError(t, err)
+	assert.Equal(t, output.MerkleRoot, mt.GetRoot())
+
+	physical, err := fs.GetTotalDiskSizeUsed()
+	require.NoError(t, err)
+	logical, err := fs.GetTotalLogicalSizeUsed()
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), logical)
+	assert.True(t, physical < logical)
+
+	var hashes []string
+	require.NoError(t, fs.IterateObjects(allocationID, func(contentHash string, _ int64) {
+		hashes = append(hashes, contentHash)
+	}))
+	assert.Equal(t, []string{output.ContentHash}, hashes)
+
+	require.NoError(t, fs.DeleteFile(allocationID, output.ContentHash))
+	_, err = os.Stat(objectPath)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCompressFile_Incompressible(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "random")
+	content := make([]byte, 3*CHUNK_SIZE)
+	_, err = rand.Read(content)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(src, content, 0600))
+
+	compressed, err := compressFile(src, src+CompressedObjectExt)
+	require.NoError(t, err)
+	assert.False(t, compressed)
+	_, err = os.Stat(src + CompressedObjectExt)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestFileFSStore_UploadObjectToCloud(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	uploaded := make(map[string][]byte)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, err := ioutil.ReadAll(r.Body)
+			assert.NoError(t, err)
+			uploaded[r.URL.Path] = body
+			return
+		}
+		// the bucket location
+		w.Write([]byte(`<LocationConstraint>us-east-1</LocationConstraint>`)) //nolint:errcheck
+	}))
+	defer server.Close()
+	client, err := minio.NewV2(strings.TrimPrefix(server.URL, "http://"), "key", "secret", false)
+	require.NoError(t, err)
+	prev := MinioConfig
+	defer func() { MinioConfig = prev }()
+	MinioConfig.BucketName = "bucket"
+
+	const allocationID = "4f928c7857fabb5737347c42204eea919a4777f893f35724f563b932f64e2367"
+	var (
+		fs      = &FileFSStore{RootDirectory: dir, Minio: client, CompressMimeTypes: []string{"text/"}}
+		content = bytes.Repeat([]byte("a very compressible line of text\n"), 10000)
+		input   = &FileInputData{Name: "notes.txt", Path: "/notes.txt", MimeType: "text/plain"}
+	)
+	output, err := fs.WriteFile(allocationID, input, filestoretest.NewMemFile(content), "connection")
+	require.NoError(t, err)
+	input.Hash = output.ContentHash
+	_, err = fs.CommitWrite(allocationID, input, "connection")
+	require.NoError(t, err)
+
+	objectPath, err := fs.UploadObjectToCloud(allocationID, input)
+	require.NoError(t, err)
+	assert.Equal(t, CompressedObjectExt, filepath.Ext(objectPath))
+	assert.Equal(t, content, uploaded["/bucket/"+output.ContentHash])
+}
@@ -0,0 +1,140 @@
+package filestore
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"0chain.net/core/common"
+	"0chain.net/core/lock"
+
+	. "0chain.net/core/logging"
+	"go.uber.org/zap"
+)
+
+// ContentDirName is the directory of the global content store, shared by all
+// allocations when deduplication is enabled. Every allocation object is a hard
+// link to the content file of its hash, so the link count of a content file is
+// the reference count of that content: one for the content store itself plus
+// one per allocation holding it.
+const ContentDirName = "content"
+
+// OrphanCollector is implemented by stores that may keep content no longer
+// referenced by any allocation, see CleanupDiskFiles.
+type OrphanCollector interface {
+	RemoveOrphanedContent() error
+}
+
+func (fs *FileFSStore) contentPath(contentHash string) string {
+	dirPath, destFile := GetFilePathFromHash(contentHash)
+	return filepath.Join(fs.RootDirectory, ContentDirName, dirPath, destFile)
+}
+
+// contentPathFor returns the content store path linked to by the allocation
+// object path, which may be a compressed object.
+func (fs *FileFSStore) contentPathFor(fileObjectPath, contentHash string) string {
+	contentPath := fs.contentPath(contentHash)
+	if strings.HasSuffix(fileObjectPath, CompressedObjectExt) {
+		contentPath += CompressedObjectExt
+	}
+	return contentPath
+}
+
+func contentMutex(contentHash string) *sync.Mutex {
+	return lock.GetMutex(ContentDirName, contentHash)
+}
+
+// linkCount returns the number of hard links of the file.
+func linkCount(info os.FileInfo) uint64 {
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		return uint64(st.Nlink)
+	}
+	return 1
+}
+
+// inode returns the inode number of the file.
+func inode(info os.FileInfo) uint64 {
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		return uint64(st.Ino)
+	}
+	return 0
+}
+
+// commitDeduplicated moves the temp file into the content store, unless the
+// content is there already, and links the allocation object to it.
+func (fs *FileFSStore) commitDeduplicated(tempFilePath, fileObjectPath, contentHash string) error {
+	contentPath := fs.contentPathFor(fileObjectPath, contentHash)
+	if err := createDirs(filepath.Dir(contentPath)); err != nil {
+		return common.NewError("blob_object_dir_creation_error", err.Error())
+	}
+
+	mutex := contentMutex(contentHash)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// link the temp file in place, it fails if the content is stored already
+	if err := os.Link(tempFilePath, contentPath); err != nil && !os.IsExist(err) {
+		return common.NewError("blob_object_creation_error", err.Error())
+	}
+	if err := os.Remove(tempFilePath); err != nil {
+		return common.NewError("blob_object_creation_error", err.Error())
+	}
+
+	// the allocation may hold the content already
+	if err := os.Link(contentPath, fileObjectPath); err != nil && !os.IsExist(err) {
+		return common.NewError("blob_object_creation_error", err.Error())
+	}
+	return nil
+}
+
+// deleteDeduplicated removes the allocation object and drops the content once
+// no allocation references it anymore.
+func (fs *FileFSStore) deleteDeduplicated(fileObjectPath, contentHash string) error {
+	mutex := contentMutex(contentHash)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	err := os.Remove(fileObjectPath)
+	fs.removeIfOrphaned(fs.contentPathFor(fileObjectPath, contentHash))
+	return err
+}
+
+func (fs *FileFSStore) removeIfOrphaned(contentPath string) {
+	info, err := os.Stat(contentPath)
+	if err != nil || linkCount(info) > 1 {
+		return
+	}
+	if err := os.Remove(contentPath); err != nil {
+		Logger.Error("Unable to remove orphaned content", zap.String("path", contentPath), zap.Error(err))
+	}
+}
+
+// RemoveOrphanedContent drops content store files no allocation links to,
+// e.g. left behind by moving cold data to the cloud.
+func (fs *FileFSStore) RemoveOrphanedContent() error {
+	if !fs.Dedup {
+		return nil
+	}
+	contentDir := filepath.Join(fs.RootDirectory, ContentDirName)
+	err := filepath.Walk(contentDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || linkCount(info) > 1 {
+			return nil
+		}
+		relPath, err := filepath.Rel(contentDir, path)
+		if err != nil {
+			return nil
+		}
+		relPath = strings.TrimSuffix(relPath, CompressedObjectExt)
+		mutex := contentMutex(strings.Replace(filepath.ToSlash(relPath), "/", "", -1))
+		mutex.Lock()
+		fs.removeIfOrphaned(path)
+		mutex.Unlock()
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,62 @@
+package filestore
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"0chain.net/blobbercore/internal/filestoretest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileFSStore_Dedup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		fs          = &FileFSStore{RootDirectory: dir, Dedup: true}
+		content     = bytes.Repeat([]byte("deduplicated"), 1000)
+		allocations = []string{
+			"4f928c7857fabb5737347c42204eea919a4777f893f35724f563b932f64e2367",
+			"9ab7ee4b9e2e9c4ddd4d1a3e87a6dd6bc2e0f6e6a7ae45e3e26e9b1c0c12b6a1",
+		}
+		contentHash string
+	)
+
+	for _, allocationID := range allocations {
+		input := &FileInputData{Name: "base.img", Path: "/base.img"}
+		output, err := fs.WriteFile(allocationID, input, filestoretest.NewMemFile(content), "connection")
+		require.NoError(t, err)
+		contentHash = output.ContentHash
+
+		input.Hash = output.ContentHash
+		_, err = fs.CommitWrite(allocationID, input, "connection")
+		require.NoError(t, err)
+
+		// each allocation is still charged for the full content
+		used, err := fs.GetlDiskSizeUsed(allocationID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(content)), used)
+	}
+
+	info, err := os.Stat(fs.contentPath(contentHash))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), linkCount(info))
+
+	total, err := fs.GetTotalDiskSizeUsed()
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), total)
+
+	require.NoError(t, fs.DeleteFile(allocations[0], contentHash))
+	block, err := fs.GetFileBlock(allocations[1], &FileInputData{Hash: contentHash}, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, content, block)
+
+	require.NoError(t, fs.DeleteFile(allocations[1], contentHash))
+	_, err = os.Stat(fs.contentPath(contentHash))
+	assert.True(t, os.IsNotExist(err))
+}
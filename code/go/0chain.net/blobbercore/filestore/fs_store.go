@@ -48,6 +48,12 @@ var MinioConfig MinioConfiguration
 type FileFSStore struct {
 	RootDirectory string
 	Minio         *minio.Client
+	// Dedup stores identical content once across allocations, see
+	// ContentDirName.
+	Dedup bool
+	// CompressMimeTypes are the mime type prefixes of the content that gets
+	// compressed at commit time, see CompressedObjectExt.
+	CompressMimeTypes []string
 }
 
 type StoreAllocation struct {
@@ -57,21 +63,42 @@ type StoreAllocation struct {
 	TempObjectsPath string
 }
 
+func init() {
+	RegisterStore(LocalStoreBackend, newFSStore)
+}
+
 func SetupFSStore(rootDir string) (FileStore, error) {
+	store, err := newFSStore(rootDir)
+	if err != nil {
+		return nil, err
+	}
+	fsStore = store
+	return fsStore, nil
+}
+
+func newFSStore(rootDir string) (FileStore, error) {
 	if err := createDirs(rootDir); err != nil {
 		return nil, err
 	}
-	fsStore = &FileFSStore{
+	store := &FileFSStore{
 		RootDirectory: rootDir,
 		Minio:         intializeMinio(),
+		Dedup:         config.Configuration.FileStoreDedup,
 	}
-	return fsStore, nil
+	if config.Configuration.FileStoreCompression {
+		store.CompressMimeTypes = config.Configuration.FileStoreCompressMimeTypes
+	}
+	return store, nil
 }
 
 func intializeMinio() *minio.Client {
 	if !config.Configuration.MinioStart {
 		return nil
 	}
+	return newMinioClient()
+}
+
+func newMinioClient() *minio.Client {
 	minioClient, err := minio.New(
 		MinioConfig.StorageServiceURL,
 		MinioConfig.AccessKeyID,
@@ -133,14 +160,56 @@ func (fs *FileFSStore) GetTempPathSize(allocationID string) (int64, error) {
 
 func (fs *FileFSStore) GetTotalDiskSizeUsed() (int64, error) {
 	var size int64
+	var seen = make(map[uint64]bool)
 	err := filepath.Walk(fs.RootDirectory, func(_ string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
-		if !info.IsDir() {
-			size += info.Size()
+		if info.IsDir() {
+			return nil
 		}
-		return err
+		// deduplicated content is linked from several allocations, but
+		// takes the disk space once
+		if linkCount(info) > 1 {
+			if seen[inode(info)] {
+				return nil
+			}
+			seen[inode(info)] = true
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
+
+func (fs *FileFSStore) GetTotalLogicalSizeUsed() (int64, error) {
+	var size int64
+	contentDir := filepath.Join(fs.RootDirectory, ContentDirName)
+	err := filepath.Walk(fs.RootDirectory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			// the content store is accounted through the allocation links
+			if path == contentDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasSuffix(path, CompressedObjectExt) {
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			contentSize, _, err := compressedContentSize(file)
+			if err == nil {
+				size += contentSize
+				return nil
+			}
+		}
+		size += info.Size()
+		return nil
 	})
 	return size, err
 }
@@ -211,121 +280,91 @@ func (fs *FileFSStore) GetFileBlockForChallenge(allocationID string, fileData *F
 	if err != nil {
 		return nil, nil, common.NewError("invalid_allocation", "Invalid allocation. "+err.Error())
 	}
-	dirPath, destFile := GetFilePathFromHash(fileData.Hash)
-	fileObjectPath := filepath.Join(allocation.ObjectsPath, dirPath)
-	fileObjectPath = filepath.Join(fileObjectPath, destFile)
-
-	file, err := os.Open(fileObjectPath)
+	file, _, err := fs.openObject(allocation, fileData)
 	if err != nil {
-		if os.IsNotExist(err) && fileData.OnCloud {
-			err = fs.DownloadFromCloud(fileData.Hash, fileObjectPath)
-			if err != nil {
-				return nil, nil, common.NewError("minio_download_failed", "Unable to download from minio with err "+err.Error())
-			}
-			file, err = os.Open(fileObjectPath)
-			if err != nil {
-				return nil, nil, err
-			}
-		} else {
-			return nil, nil, err
-		}
+		return nil, nil, err
 	}
 	defer file.Close()
 
-	if blockoffset < 0 || blockoffset >= 1024 {
+	if blockoffset < 0 || blockoffset >= merkleLeavesCount {
 		return nil, nil, common.NewError("invalid_block_number", "Invalid block offset")
 	}
 
-	var returnBytes []byte
-
-	merkleHashes := make([]hash.Hash, 1024)
-	merkleLeaves := make([]util.Hashable, 1024)
-	for idx := range merkleHashes {
-		merkleHashes[idx] = sha3.New256()
-	}
-	bytesBuf := bytes.NewBuffer(make([]byte, 0))
-	for {
-		_, err := io.CopyN(bytesBuf, file, CHUNK_SIZE)
-		if err != io.EOF && err != nil {
-			return nil, nil, common.NewError("file_write_error", err.Error())
-		}
-		dataBytes := bytesBuf.Bytes()
-		tmpBytes := make([]byte, len(dataBytes))
-		copy(tmpBytes, dataBytes)
-		merkleChunkSize := 64
-		for i := 0; i < len(tmpBytes); i += merkleChunkSize {
-			end := i + merkleChunkSize
-			if end > len(tmpBytes) {
-				end = len(tmpBytes)
-			}
-			offset := i / merkleChunkSize
-			merkleHashes[offset].Write(tmpBytes[i:end])
-			if offset == blockoffset {
-				returnBytes = append(returnBytes, tmpBytes[i:end]...)
-			}
-		}
-		bytesBuf.Reset()
-		if err != nil && err == io.EOF {
-			break
-		}
-	}
-
-	for idx := range merkleHashes {
-		merkleLeaves[idx] = util.NewStringHashable(hex.EncodeToString(merkleHashes[idx].Sum(nil)))
+	mt, returnBytes, err := computeMerkleTree(file, blockoffset)
+	if err != nil {
+		return nil, nil, err
 	}
-	var mt util.MerkleTreeI = &util.MerkleTree{}
-	mt.ComputeTree(merkleLeaves)
-
 	return returnBytes, mt, nil
 }
 
-func (fs *FileFSStore) GetFileBlock(allocationID string, fileData *FileInputData, blockNum int64, numBlocks int64) ([]byte, error) {
-	allocation, err := fs.SetupAllocation(allocationID, true)
-	if err != nil {
-		return nil, common.NewError("invalid_allocation", "Invalid allocation. "+err.Error())
-	}
-	dirPath, destFile := GetFilePathFromHash(fileData.Hash)
-	fileObjectPath := filepath.Join(allocation.ObjectsPath, dirPath)
-	fileObjectPath = filepath.Join(fileObjectPath, destFile)
+// openObject opens the committed object of the file, fetching it back from
+// the cloud first if only the cloud copy is left. Compressed objects are
+// decompressed on the fly. It returns the content size as well.
+func (fs *FileFSStore) openObject(allocation *StoreAllocation, fileData *FileInputData) (ObjectReader, int64, error) {
+	fileObjectPath := fs.objectPath(allocation, fileData.Hash)
 
 	file, err := os.Open(fileObjectPath)
+	if err != nil && os.IsNotExist(err) && fileData.OnCloud {
+		err = fs.DownloadFromCloud(fileData.Hash, fileObjectPath)
+		if err != nil {
+			return nil, 0, common.NewError("minio_download_failed", "Unable to download from minio with err "+err.Error())
+		}
+		file, err = os.Open(fileObjectPath)
+	}
 	if err != nil {
-		if os.IsNotExist(err) && fileData.OnCloud {
-			err = fs.DownloadFromCloud(fileData.Hash, fileObjectPath)
-			if err != nil {
-				return nil, common.NewError("minio_download_failed", "Unable to download from minio with err "+err.Error())
-			}
-			file, err = os.Open(fileObjectPath)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			return nil, err
+		return nil, 0, err
+	}
+
+	if strings.HasSuffix(fileObjectPath, CompressedObjectExt) {
+		reader, err := newCompressedReader(file)
+		if err != nil {
+			file.Close()
+			return nil, 0, common.NewError("invalid_compressed_object", err.Error())
 		}
+		return reader, reader.size, nil
 	}
-	defer file.Close()
+
 	fileinfo, err := file.Stat()
 	if err != nil {
-		return nil, err
+		file.Close()
+		return nil, 0, err
 	}
+	return file, fileinfo.Size(), nil
+}
 
-	filesize := int(fileinfo.Size())
-	maxBlockNum := int64(filesize / CHUNK_SIZE)
-	// check for any left over bytes. Add one more go routine if required.
-	if remainder := filesize % CHUNK_SIZE; remainder != 0 {
-		maxBlockNum++
+// objectPath returns the path of the committed object of the content hash,
+// which has the CompressedObjectExt if the content was stored compressed.
+func (fs *FileFSStore) objectPath(allocation *StoreAllocation, contentHash string) string {
+	dirPath, destFile := GetFilePathFromHash(contentHash)
+	fileObjectPath := filepath.Join(allocation.ObjectsPath, dirPath, destFile)
+	if _, err := os.Stat(fileObjectPath); os.IsNotExist(err) {
+		if _, err := os.Stat(fileObjectPath + CompressedObjectExt); err == nil {
+			return fileObjectPath + CompressedObjectExt
+		}
 	}
+	return fileObjectPath
+}
 
-	if blockNum > maxBlockNum || blockNum < 1 {
-		return nil, common.NewError("invalid_block_number", "Invalid block number")
+func (fs *FileFSStore) GetFileReader(allocationID string, fileData *FileInputData) (ObjectReader, int64, error) {
+	allocation, err := fs.SetupAllocation(allocationID, true)
+	if err != nil {
+		return nil, 0, common.NewError("invalid_allocation", "Invalid allocation. "+err.Error())
 	}
-	buffer := make([]byte, CHUNK_SIZE*numBlocks)
-	n, err := file.ReadAt(buffer, ((blockNum - 1) * CHUNK_SIZE))
-	if err != nil && err != io.EOF {
+	return fs.openObject(allocation, fileData)
+}
+
+func (fs *FileFSStore) GetFileBlock(allocationID string, fileData *FileInputData, blockNum int64, numBlocks int64) ([]byte, error) {
+	allocation, err := fs.SetupAllocation(allocationID, true)
+	if err != nil {
+		return nil, common.NewError("invalid_allocation", "Invalid allocation. "+err.Error())
+	}
+	file, size, err := fs.openObject(allocation, fileData)
+	if err != nil {
 		return nil, err
 	}
+	defer file.Close()
 
-	return buffer[:n], nil
+	return readFileBlocks(file, size, blockNum, numBlocks)
 }
 
 func (fs *FileFSStore) DeleteTempFile(allocationID string, fileData *FileInputData, connectionID string) error {
@@ -377,6 +416,25 @@ func (fs *FileFSStore) CommitWrite(allocationID string, fileData *FileInputData,
 		return false, common.NewError("blob_object_dir_creation_error", err.Error())
 	}
 	fileObjectPath = filepath.Join(fileObjectPath, destFile)
+	if isCompressible(fileData.MimeType, fs.CompressMimeTypes) {
+		compressed, err := compressFile(tempFilePath, tempFilePath+CompressedObjectExt)
+		if err != nil {
+			return false, common.NewError("blob_object_compression_error", err.Error())
+		}
+		if compressed {
+			if err := os.Remove(tempFilePath); err != nil {
+				return false, common.NewError("blob_object_compression_error", err.Error())
+			}
+			tempFilePath += CompressedObjectExt
+			fileObjectPath += CompressedObjectExt
+		}
+	}
+	if fs.Dedup {
+		if err := fs.commitDeduplicated(tempFilePath, fileObjectPath, fileData.Hash); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
 	//if _, err := os.Stat(fileObjectPath); os.IsNotExist(err) {
 	err = os.Rename(tempFilePath, fileObjectPath)
 
@@ -395,9 +453,7 @@ func (fs *FileFSStore) DeleteFile(allocationID string, contentHash string) error
 		return common.NewError("filestore_setup_error", "Error setting the fs store. "+err.Error())
 	}
 
-	dirPath, destFile := GetFilePathFromHash(contentHash)
-	fileObjectPath := filepath.Join(allocation.ObjectsPath, dirPath)
-	fileObjectPath = filepath.Join(fileObjectPath, destFile)
+	fileObjectPath := fs.objectPath(allocation, contentHash)
 
 	if config.Configuration.ColdStorageDeleteCloudCopy {
 		err = fs.RemoveFromCloud(contentHash)
@@ -406,6 +462,9 @@ func (fs *FileFSStore) DeleteFile(allocationID string, contentHash string) error
 		}
 	}
 
+	if fs.Dedup {
+		return fs.deleteDeduplicated(fileObjectPath, contentHash)
+	}
 	return os.Remove(fileObjectPath)
 }
 
@@ -414,65 +473,13 @@ func (fs *FileFSStore) GetMerkleTreeForFile(allocationID string, fileData *FileI
 	if err != nil {
 		return nil, common.NewError("filestore_setup_error", "Error setting the fs store. "+err.Error())
 	}
-	dirPath, destFile := GetFilePathFromHash(fileData.Hash)
-	fileObjectPath := filepath.Join(allocation.ObjectsPath, dirPath)
-	fileObjectPath = filepath.Join(fileObjectPath, destFile)
-
-	file, err := os.Open(fileObjectPath)
+	file, _, err := fs.openObject(allocation, fileData)
 	if err != nil {
-		if os.IsNotExist(err) && fileData.OnCloud {
-			err = fs.DownloadFromCloud(fileData.Hash, fileObjectPath)
-			if err != nil {
-				return nil, common.NewError("minio_download_failed", "Unable to download from minio with err "+err.Error())
-			}
-			file, err = os.Open(fileObjectPath)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			return nil, err
-		}
+		return nil, err
 	}
 	defer file.Close()
-	//merkleHash := sha3.New256()
-	tReader := file //io.TeeReader(file, merkleHash)
-	//merkleLeaves := make([]util.Hashable, 0)
-	merkleHashes := make([]hash.Hash, 1024)
-	merkleLeaves := make([]util.Hashable, 1024)
-	for idx := range merkleHashes {
-		merkleHashes[idx] = sha3.New256()
-	}
-	bytesBuf := bytes.NewBuffer(make([]byte, 0))
-	for {
-		_, err := io.CopyN(bytesBuf, tReader, CHUNK_SIZE)
-		if err != io.EOF && err != nil {
-			return nil, common.NewError("file_write_error", err.Error())
-		}
-		dataBytes := bytesBuf.Bytes()
-		merkleChunkSize := 64
-		for i := 0; i < len(dataBytes); i += merkleChunkSize {
-			end := i + merkleChunkSize
-			if end > len(dataBytes) {
-				end = len(dataBytes)
-			}
-			offset := i / merkleChunkSize
-			merkleHashes[offset].Write(dataBytes[i:end])
-		}
-		//merkleLeaves = append(merkleLeaves, util.NewStringHashable(hex.EncodeToString(merkleHash.Sum(nil))))
-		//merkleHash.Reset()
-		bytesBuf.Reset()
-		if err != nil && err == io.EOF {
-			break
-		}
-	}
-	for idx := range merkleHashes {
-		merkleLeaves[idx] = util.NewStringHashable(hex.EncodeToString(merkleHashes[idx].Sum(nil)))
-	}
-
-	var mt util.MerkleTreeI = &util.MerkleTree{}
-	mt.ComputeTree(merkleLeaves)
-
-	return mt, nil
+	mt, _, err := computeMerkleTree(file, -1)
+	return mt, err
 }
 
 func (fs *FileFSStore) WriteFile(allocationID string, fileData *FileInputData,
@@ -585,6 +592,15 @@ func (fs *FileFSStore) IterateObjects(allocationID string, handler FileObjectHan
 	}
 	return filepath.Walk(allocation.ObjectsPath, func(path string, info os.FileInfo, err error) error {
 		if err == nil && !info.IsDir() && !strings.HasPrefix(path, allocation.TempObjectsPath) {
+			// compressed objects are named after the hash of their content
+			if strings.HasSuffix(path, CompressedObjectExt) {
+				relPath, err := filepath.Rel(allocation.ObjectsPath, strings.TrimSuffix(path, CompressedObjectExt))
+				if err != nil {
+					return nil
+				}
+				handler(strings.Replace(relPath, OSPathSeperator, "", -1), info.Size())
+				return nil
+			}
 			f, err := os.Open(path)
 			if err != nil {
 				return nil
@@ -609,10 +625,77 @@ func (fs *FileFSStore) UploadToCloud(fileHash, filePath string) error {
 	return nil
 }
 
+// UploadObjectToCloud uploads the content of the committed object of the file
+// to the cloud, decompressed as DownloadFromCloud and RestoreFromCloud expect
+// it. It returns the path of the local object, which may be compressed.
+func (fs *FileFSStore) UploadObjectToCloud(allocationID string, fileData *FileInputData) (string, error) {
+	allocation, err := fs.SetupAllocation(allocationID, true)
+	if err != nil {
+		return "", common.NewError("invalid_allocation", "Invalid allocation. "+err.Error())
+	}
+	fileObjectPath := fs.objectPath(allocation, fileData.Hash)
+	reader, size, err := fs.openObject(allocation, &FileInputData{Hash: fileData.Hash})
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	_, err = fs.Minio.PutObject(MinioConfig.BucketName, fileData.Hash, reader, size, minio.PutObjectOptions{})
+	if err != nil {
+		return "", err
+	}
+	return fileObjectPath, nil
+}
+
 func (fs *FileFSStore) DownloadFromCloud(fileHash, filePath string) error {
 	return fs.Minio.FGetObject(MinioConfig.BucketName, fileHash, filePath, minio.GetObjectOptions{})
 }
 
+// RestoreFromCloud replaces the committed object of the file with its cloud
+// copy, once the copy is checked against the content hash of the file. The
+// deduplicated objects are shared by allocations and are not restored.
+func (fs *FileFSStore) RestoreFromCloud(allocationID string, fileData *FileInputData) error {
+	if fs.Dedup {
+		return common.NewError("not_supported", "Deduplicated objects are not restored from the cloud")
+	}
+	allocation, err := fs.SetupAllocation(allocationID, true)
+	if err != nil {
+		return common.NewError("invalid_allocation", "Invalid allocation. "+err.Error())
+	}
+	dirPath, destFile := GetFilePathFromHash(fileData.Hash)
+	fileObjectPath := filepath.Join(allocation.ObjectsPath, dirPath, destFile)
+	if err = createDirs(filepath.Dir(fileObjectPath)); err != nil {
+		return common.NewError("blob_object_dir_creation_error", err.Error())
+	}
+
+	restorePath := fileObjectPath + ".restore"
+	defer os.Remove(restorePath)
+	if err = fs.DownloadFromCloud(fileData.Hash, restorePath); err != nil {
+		return common.NewError("minio_download_failed", "Unable to download from minio with err "+err.Error())
+	}
+	file, err := os.Open(restorePath)
+	if err != nil {
+		return err
+	}
+	contentHash, _, err := ComputeObjectHashes(file)
+	file.Close()
+	if err != nil {
+		return err
+	}
+	if contentHash != fileData.Hash {
+		return common.NewError("invalid_cloud_copy", "The cloud copy does not match the content hash "+fileData.Hash)
+	}
+
+	if err = os.Rename(restorePath, fileObjectPath); err != nil {
+		return err
+	}
+	// the cloud copy is not compressed, the compressed object is replaced
+	if err = os.Remove(fileObjectPath + CompressedObjectExt); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
 func (fs *FileFSStore) RemoveFromCloud(fileHash string) error {
 	if _, err := fs.Minio.StatObject(MinioConfig.BucketName, fileHash, minio.StatObjectOptions{}); err == nil {
 		return fs.Minio.RemoveObject(MinioConfig.BucketName, fileHash)
@@ -0,0 +1,268 @@
+package filestore
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"mime/multipart"
+	"sync"
+
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/core/util"
+)
+
+func init() {
+	RegisterStore(MemoryStoreBackend, func(string) (FileStore, error) {
+		return NewMemFileStore(), nil
+	})
+}
+
+// MemFileStore keeps all file content in memory. It is meant for tests and
+// throwaway deployments; nothing survives a restart.
+type MemFileStore struct {
+	mu sync.RWMutex
+	// objects maps an allocation id to its committed content by content hash
+	objects map[string]map[string][]byte
+	// temp maps an allocation id to its uncommitted uploads by temp name
+	temp map[string]map[string][]byte
+}
+
+func NewMemFileStore() *MemFileStore {
+	return &MemFileStore{
+		objects: make(map[string]map[string][]byte),
+		temp:    make(map[string]map[string][]byte),
+	}
+}
+
+func (ms *MemFileStore) tempName(fileData *FileInputData, connectionID string) string {
+	return fileData.Name + "." + encryption.Hash(fileData.Path) + "." + connectionID
+}
+
+func (ms *MemFileStore) SetupAllocation(allocationID string, skipCreate bool) (*StoreAllocation, error) {
+	allocation := &StoreAllocation{
+		ID:              allocationID,
+		Path:            allocationID,
+		ObjectsPath:     allocationID + "/" + ObjectsDirName,
+		TempObjectsPath: allocationID + "/" + ObjectsDirName + "/" + TempObjectsDirName,
+	}
+	if skipCreate {
+		return allocation, nil
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if _, ok := ms.objects[allocationID]; !ok {
+		ms.objects[allocationID] = make(map[string][]byte)
+	}
+	if _, ok := ms.temp[allocationID]; !ok {
+		ms.temp[allocationID] = make(map[string][]byte)
+	}
+	return allocation, nil
+}
+
+func (ms *MemFileStore) WriteFile(allocationID string, fileData *FileInputData,
+	infile multipart.File, connectionID string) (*FileOutputData, error) {
+
+	if _, err := ms.SetupAllocation(allocationID, false); err != nil {
+		return nil, common.NewError("filestore_setup_error", "Error setting the fs store. "+err.Error())
+	}
+
+	data, err := ioutil.ReadAll(infile)
+	if err != nil {
+		return nil, common.NewError("file_write_error", err.Error())
+	}
+
+	fileRef := &FileOutputData{
+		Name:         fileData.Name,
+		Path:         fileData.Path,
+		UploadLength: fileData.UploadLength,
+	}
+	name := ms.tempName(fileData, connectionID)
+
+	ms.mu.Lock()
+	content := ms.temp[allocationID][name]
+	if fileData.IsResumable {
+		if fileData.UploadOffset > int64(len(content)) {
+			ms.mu.Unlock()
+			return nil, common.NewError("file_write_error", "Upload offset is beyond the uploaded content")
+		}
+		content = append(content[:fileData.UploadOffset], data...)
+	} else {
+		content = data
+	}
+	ms.temp[allocationID][name] = content
+	ms.mu.Unlock()
+
+	h := sha1.New()
+	if fileData.IsResumable && !fileData.IsFinal {
+		//skip to compute hash until the last chunk is uploaded
+		h.Write(data)
+		fileRef.ContentHash = hex.EncodeToString(h.Sum(nil))
+		fileRef.Size = int64(len(content))
+		fileRef.UploadOffset = fileData.UploadOffset + int64(len(data))
+		return fileRef, nil
+	}
+
+	h.Write(content)
+	mt, _, err := computeMerkleTree(bytes.NewReader(content), -1)
+	if err != nil {
+		return nil, err
+	}
+
+	fileRef.ContentHash = hex.EncodeToString(h.Sum(nil))
+	fileRef.Size = int64(len(content))
+	fileRef.MerkleRoot = mt.GetRoot()
+	fileRef.UploadOffset = int64(len(content))
+
+	return fileRef, nil
+}
+
+func (ms *MemFileStore) DeleteTempFile(allocationID string, fileData *FileInputData, connectionID string) error {
+	name := ms.tempName(fileData, connectionID)
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if _, ok := ms.temp[allocationID][name]; !ok {
+		return common.NewError("file_not_found", "Temp file not found "+name)
+	}
+	delete(ms.temp[allocationID], name)
+	return nil
+}
+
+func (ms *MemFileStore) CommitWrite(allocationID string, fileData *FileInputData, connectionID string) (bool, error) {
+	name := ms.tempName(fileData, connectionID)
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	content, ok := ms.temp[allocationID][name]
+	if !ok {
+		return false, common.NewError("blob_object_creation_error", "Temp file not found "+name)
+	}
+	if _, ok := ms.objects[allocationID]; !ok {
+		ms.objects[allocationID] = make(map[string][]byte)
+	}
+	ms.objects[allocationID][fileData.Hash] = content
+	delete(ms.temp[allocationID], name)
+	return true, nil
+}
+
+func (ms *MemFileStore) getObject(allocationID, contentHash string) ([]byte, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	content, ok := ms.objects[allocationID][contentHash]
+	if !ok {
+		return nil, common.NewError("file_not_found", "Object not found "+contentHash)
+	}
+	return content, nil
+}
+
+func (ms *MemFileStore) GetFileBlock(allocationID string, fileData *FileInputData, blockNum int64, numBlocks int64) ([]byte, error) {
+	content, err := ms.getObject(allocationID, fileData.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return readFileBlocks(bytes.NewReader(content), int64(len(content)), blockNum, numBlocks)
+}
+
+type memObjectReader struct {
+	*bytes.Reader
+}
+
+func (memObjectReader) Close() error { return nil }
+
+func (ms *MemFileStore) GetFileReader(allocationID string, fileData *FileInputData) (ObjectReader, int64, error) {
+	content, err := ms.getObject(allocationID, fileData.Hash)
+	if err != nil {
+		return nil, 0, err
+	}
+	return memObjectReader{bytes.NewReader(content)}, int64(len(content)), nil
+}
+
+func (ms *MemFileStore) GetFileBlockForChallenge(allocationID string, fileData *FileInputData, blockoffset int) (json.RawMessage, util.MerkleTreeI, error) {
+	content, err := ms.getObject(allocationID, fileData.Hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if blockoffset < 0 || blockoffset >= merkleLeavesCount {
+		return nil, nil, common.NewError("invalid_block_number", "Invalid block offset")
+	}
+	mt, returnBytes, err := computeMerkleTree(bytes.NewReader(content), blockoffset)
+	if err != nil {
+		return nil, nil, err
+	}
+	return returnBytes, mt, nil
+}
+
+func (ms *MemFileStore) DeleteFile(allocationID string, contentHash string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if _, ok := ms.objects[allocationID][contentHash]; !ok {
+		return common.NewError("file_not_found", "Object not found "+contentHash)
+	}
+	delete(ms.objects[allocationID], contentHash)
+	return nil
+}
+
+func (ms *MemFileStore) GetTotalDiskSizeUsed() (int64, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	var size int64
+	for _, objects := range ms.objects {
+		size += sizeOfContents(objects)
+	}
+	for _, temp := range ms.temp {
+		size += sizeOfContents(temp)
+	}
+	return size, nil
+}
+
+func (ms *MemFileStore) GetTotalLogicalSizeUsed() (int64, error) {
+	return ms.GetTotalDiskSizeUsed()
+}
+
+func (ms *MemFileStore) GetlDiskSizeUsed(allocationID string) (int64, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	return sizeOfContents(ms.objects[allocationID]) + sizeOfContents(ms.temp[allocationID]), nil
+}
+
+func (ms *MemFileStore) GetTempPathSize(allocationID string) (int64, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	return sizeOfContents(ms.temp[allocationID]), nil
+}
+
+func (ms *MemFileStore) IterateObjects(allocationID string, handler FileObjectHandler) error {
+	ms.mu.RLock()
+	sizes := make(map[string]int64, len(ms.objects[allocationID]))
+	for contentHash, content := range ms.objects[allocationID] {
+		sizes[contentHash] = int64(len(content))
+	}
+	ms.mu.RUnlock()
+
+	// the handler may delete objects, so it is called without the lock held
+	for contentHash, size := range sizes {
+		handler(contentHash, size)
+	}
+	return nil
+}
+
+// UploadToCloud is a no-op, there is no cold tier for in-memory content.
+func (ms *MemFileStore) UploadToCloud(fileHash, filePath string) error {
+	return nil
+}
+
+func (ms *MemFileStore) DownloadFromCloud(fileHash, filePath string) error {
+	return common.NewError("not_supported", "Memory file store has no cloud copies")
+}
+
+func sizeOfContents(contents map[string][]byte) int64 {
+	var size int64
+	for _, content := range contents {
+		size += int64(len(content))
+	}
+	return size
+}
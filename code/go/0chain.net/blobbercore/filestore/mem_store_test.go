@@ -0,0 +1,96 @@
+package filestore
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"0chain.net/blobbercore/internal/filestoretest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupStore(t *testing.T) {
+	store, err := SetupStore(MemoryStoreBackend, "")
+	require.NoError(t, err)
+	assert.IsType(t, &MemFileStore{}, store)
+	assert.Equal(t, store, GetFileStore())
+
+	_, err = SetupStore("unknown", "")
+	require.Error(t, err)
+
+	dir, err := ioutil.TempDir("", "filestore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err = SetupStore("", dir)
+	require.NoError(t, err)
+	assert.IsType(t, &FileFSStore{}, store)
+}
+
+func TestMemFileStore(t *testing.T) {
+	const (
+		allocationID = "4f928c7857fabb5737347c42204eea919a4777f893f35724f563b932f64e2367"
+		connectionID = "connection"
+	)
+	content := bytes.Repeat([]byte("0123456789abcdef"), 5000)
+
+	ms := NewMemFileStore()
+	input := &FileInputData{Name: "file.txt", Path: "/file.txt"}
+	output, err := ms.WriteFile(allocationID, input, filestoretest.NewMemFile(content), connectionID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), output.Size)
+	assert.NotEmpty(t, output.MerkleRoot)
+
+	tempSize, err := ms.GetTempPathSize(allocationID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), tempSize)
+
+	input.Hash = output.ContentHash
+	ok, err := ms.CommitWrite(allocationID, input, connectionID)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	tempSize, err = ms.GetTempPathSize(allocationID)
+	require.NoError(t, err)
+	assert.Zero(t, tempSize)
+
+	block, err := ms.GetFileBlock(allocationID, input, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, content[CHUNK_SIZE:], block)
+
+	_, err = ms.GetFileBlock(allocationID, input, 3, 1)
+	require.Error(t, err)
+
+	_, mt, err := ms.GetFileBlockForChallenge(allocationID, input, 10)
+	require.NoError(t, err)
+	assert.Equal(t, output.MerkleRoot, mt.GetRoot())
+
+	var hashes []string
+	err = ms.IterateObjects(allocationID, func(contentHash string, contentSize int64) {
+		hashes = append(hashes, contentHash)
+		assert.Equal(t, int64(len(content)), contentSize)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{output.ContentHash}, hashes)
+
+	require.NoError(t, ms.DeleteFile(allocationID, output.ContentHash))
+	used, err := ms.GetlDiskSizeUsed(allocationID)
+	require.NoError(t, err)
+	assert.Zero(t, used)
+}
+
+func TestComputeObjectHashes(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789abcdef"), 5000)
+	output, err := NewMemFileStore().WriteFile("allocation",
+		&FileInputData{Name: "file.txt", Path: "/file.txt"},
+		filestoretest.NewMemFile(content), "connection")
+	require.NoError(t, err)
+
+	contentHash, merkleRoot, err := ComputeObjectHashes(bytes.NewReader(content))
+	require.NoError(t, err)
+	assert.Equal(t, output.ContentHash, contentHash)
+	assert.Equal(t, output.MerkleRoot, merkleRoot)
+}
@@ -0,0 +1,95 @@
+package filestore
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"hash"
+	"io"
+
+	"0chain.net/core/common"
+	"0chain.net/core/util"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	merkleLeavesCount = 1024
+	merkleChunkSize   = 64
+)
+
+// computeMerkleTree reads the content in CHUNK_SIZE pieces and feeds every
+// 64 byte segment of a chunk into the matching one of the 1024 merkle leaves.
+// The bytes that went into the leaf at blockoffset are returned as well; pass
+// a negative blockoffset when they are not needed.
+func computeMerkleTree(r io.Reader, blockoffset int) (util.MerkleTreeI, []byte, error) {
+	var returnBytes []byte
+
+	merkleHashes := make([]hash.Hash, merkleLeavesCount)
+	merkleLeaves := make([]util.Hashable, merkleLeavesCount)
+	for idx := range merkleHashes {
+		merkleHashes[idx] = sha3.New256()
+	}
+	bytesBuf := bytes.NewBuffer(make([]byte, 0))
+	for {
+		_, err := io.CopyN(bytesBuf, r, CHUNK_SIZE)
+		if err != io.EOF && err != nil {
+			return nil, nil, common.NewError("file_write_error", err.Error())
+		}
+		dataBytes := bytesBuf.Bytes()
+		for i := 0; i < len(dataBytes); i += merkleChunkSize {
+			end := i + merkleChunkSize
+			if end > len(dataBytes) {
+				end = len(dataBytes)
+			}
+			offset := i / merkleChunkSize
+			merkleHashes[offset].Write(dataBytes[i:end])
+			if offset == blockoffset {
+				returnBytes = append(returnBytes, dataBytes[i:end]...)
+			}
+		}
+		bytesBuf.Reset()
+		if err == io.EOF {
+			break
+		}
+	}
+
+	for idx := range merkleHashes {
+		merkleLeaves[idx] = util.NewStringHashable(hex.EncodeToString(merkleHashes[idx].Sum(nil)))
+	}
+	var mt util.MerkleTreeI = &util.MerkleTree{}
+	mt.ComputeTree(merkleLeaves)
+
+	return mt, returnBytes, nil
+}
+
+// ComputeObjectHashes reads the whole content and returns its content hash
+// and the merkle root of its 1024 leaves, as computed when it was uploaded.
+func ComputeObjectHashes(r io.Reader) (contentHash, merkleRoot string, err error) {
+	h := sha1.New()
+	mt, _, err := computeMerkleTree(io.TeeReader(r, h), -1)
+	if err != nil {
+		return "", "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), mt.GetRoot(), nil
+}
+
+// readFileBlocks reads numBlocks CHUNK_SIZE blocks starting at the 1-based
+// blockNum from content of the given size.
+func readFileBlocks(r io.ReaderAt, size int64, blockNum int64, numBlocks int64) ([]byte, error) {
+	maxBlockNum := size / CHUNK_SIZE
+	// check for any left over bytes. Add one more block if required.
+	if remainder := size % CHUNK_SIZE; remainder != 0 {
+		maxBlockNum++
+	}
+
+	if blockNum > maxBlockNum || blockNum < 1 {
+		return nil, common.NewError("invalid_block_number", "Invalid block number")
+	}
+	buffer := make([]byte, CHUNK_SIZE*numBlocks)
+	n, err := r.ReadAt(buffer, (blockNum-1)*CHUNK_SIZE)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return buffer[:n], nil
+}
@@ -0,0 +1,193 @@
+package filestore
+
+import (
+	"encoding/json"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"0chain.net/core/common"
+	"0chain.net/core/util"
+	"github.com/minio/minio-go"
+)
+
+func init() {
+	RegisterStore(S3StoreBackend, newS3Store)
+}
+
+// S3FileStore keeps committed objects in an S3 compatible bucket, configured
+// through MinioConfig. Uploads are staged on the local disk under the root
+// directory until they are committed.
+type S3FileStore struct {
+	staging *FileFSStore
+	Client  *minio.Client
+	Bucket  string
+}
+
+func newS3Store(rootDir string) (FileStore, error) {
+	if err := createDirs(rootDir); err != nil {
+		return nil, err
+	}
+	return &S3FileStore{
+		staging: &FileFSStore{RootDirectory: rootDir},
+		Client:  newMinioClient(),
+		Bucket:  MinioConfig.BucketName,
+	}, nil
+}
+
+// objectKey returns the bucket key of a content hash within an allocation,
+// mirroring the on-disk layout of the local store.
+func objectKey(allocationID, contentHash string) string {
+	dirPath, destFile := GetFilePathFromHash(contentHash)
+	return objectsPrefix(allocationID) + filepath.ToSlash(dirPath) + "/" + destFile
+}
+
+func objectsPrefix(allocationID string) string {
+	return allocationID + "/" + ObjectsDirName + "/"
+}
+
+func (s3 *S3FileStore) SetupAllocation(allocationID string, skipCreate bool) (*StoreAllocation, error) {
+	return s3.staging.SetupAllocation(allocationID, skipCreate)
+}
+
+func (s3 *S3FileStore) WriteFile(allocationID string, fileData *FileInputData,
+	infile multipart.File, connectionID string) (*FileOutputData, error) {
+	return s3.staging.WriteFile(allocationID, fileData, infile, connectionID)
+}
+
+func (s3 *S3FileStore) DeleteTempFile(allocationID string, fileData *FileInputData, connectionID string) error {
+	return s3.staging.DeleteTempFile(allocationID, fileData, connectionID)
+}
+
+func (s3 *S3FileStore) GetTempPathSize(allocationID string) (int64, error) {
+	return s3.staging.GetTempPathSize(allocationID)
+}
+
+func (s3 *S3FileStore) CommitWrite(allocationID string, fileData *FileInputData, connectionID string) (bool, error) {
+	allocation, err := s3.staging.SetupAllocation(allocationID, true)
+	if err != nil {
+		return false, common.NewError("filestore_setup_error", "Error setting the fs store. "+err.Error())
+	}
+	tempFilePath := s3.staging.generateTempPath(allocation, fileData, connectionID)
+	_, err = s3.Client.FPutObject(s3.Bucket, objectKey(allocationID, fileData.Hash), tempFilePath, minio.PutObjectOptions{})
+	if err != nil {
+		return false, common.NewError("blob_object_creation_error", err.Error())
+	}
+	if err := os.Remove(tempFilePath); err != nil {
+		return false, common.NewError("blob_object_creation_error", err.Error())
+	}
+	return true, nil
+}
+
+func (s3 *S3FileStore) getObject(allocationID, contentHash string) (*minio.Object, int64, error) {
+	object, err := s3.Client.GetObject(s3.Bucket, objectKey(allocationID, contentHash), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := object.Stat()
+	if err != nil {
+		object.Close()
+		return nil, 0, err
+	}
+	return object, info.Size, nil
+}
+
+func (s3 *S3FileStore) GetFileBlock(allocationID string, fileData *FileInputData, blockNum int64, numBlocks int64) ([]byte, error) {
+	object, size, err := s3.getObject(allocationID, fileData.Hash)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+	return readFileBlocks(object, size, blockNum, numBlocks)
+}
+
+func (s3 *S3FileStore) GetFileReader(allocationID string, fileData *FileInputData) (ObjectReader, int64, error) {
+	return s3.getObject(allocationID, fileData.Hash)
+}
+
+func (s3 *S3FileStore) GetFileBlockForChallenge(allocationID string, fileData *FileInputData, blockoffset int) (json.RawMessage, util.MerkleTreeI, error) {
+	if blockoffset < 0 || blockoffset >= merkleLeavesCount {
+		return nil, nil, common.NewError("invalid_block_number", "Invalid block offset")
+	}
+	object, _, err := s3.getObject(allocationID, fileData.Hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer object.Close()
+
+	mt, returnBytes, err := computeMerkleTree(object, blockoffset)
+	if err != nil {
+		return nil, nil, err
+	}
+	return returnBytes, mt, nil
+}
+
+func (s3 *S3FileStore) DeleteFile(allocationID string, contentHash string) error {
+	return s3.Client.RemoveObject(s3.Bucket, objectKey(allocationID, contentHash))
+}
+
+func (s3 *S3FileStore) listObjects(prefix string, handler func(info minio.ObjectInfo)) error {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	for info := range s3.Client.ListObjectsV2(s3.Bucket, prefix, true, doneCh) {
+		if info.Err != nil {
+			return info.Err
+		}
+		handler(info)
+	}
+	return nil
+}
+
+func (s3 *S3FileStore) GetTotalDiskSizeUsed() (int64, error) {
+	size, err := s3.staging.GetTotalDiskSizeUsed()
+	if err != nil {
+		return 0, err
+	}
+	err = s3.listObjects("", func(info minio.ObjectInfo) {
+		size += info.Size
+	})
+	return size, err
+}
+
+func (s3 *S3FileStore) GetTotalLogicalSizeUsed() (int64, error) {
+	return s3.GetTotalDiskSizeUsed()
+}
+
+func (s3 *S3FileStore) GetlDiskSizeUsed(allocationID string) (int64, error) {
+	size, err := s3.staging.GetlDiskSizeUsed(allocationID)
+	if err != nil && !os.IsNotExist(err) {
+		return 0, err
+	}
+	err = s3.listObjects(objectsPrefix(allocationID), func(info minio.ObjectInfo) {
+		size += info.Size
+	})
+	return size, err
+}
+
+func (s3 *S3FileStore) IterateObjects(allocationID string, handler FileObjectHandler) error {
+	prefix := objectsPrefix(allocationID)
+	var objects []minio.ObjectInfo
+	err := s3.listObjects(prefix, func(info minio.ObjectInfo) {
+		objects = append(objects, info)
+	})
+	if err != nil {
+		return err
+	}
+	// objects are keyed by their content hash, so it is recovered from the key
+	// instead of downloading and hashing the content
+	for _, info := range objects {
+		contentHash := strings.Replace(strings.TrimPrefix(info.Key, prefix), "/", "", -1)
+		handler(contentHash, info.Size)
+	}
+	return nil
+}
+
+// UploadToCloud is a no-op, committed content already lives in the bucket.
+func (s3 *S3FileStore) UploadToCloud(fileHash, filePath string) error {
+	return nil
+}
+
+func (s3 *S3FileStore) DownloadFromCloud(fileHash, filePath string) error {
+	return common.NewError("not_supported", "S3 file store keeps no local copies")
+}
@@ -2,8 +2,10 @@ package filestore
 
 import (
 	"encoding/json"
+	"io"
 	"mime/multipart"
 
+	"0chain.net/core/common"
 	"0chain.net/core/util"
 )
 
@@ -14,6 +16,8 @@ type FileInputData struct {
 	Path    string
 	Hash    string
 	OnCloud bool
+	// MimeType of the content, used to decide whether it is compressed
+	MimeType string
 
 	//IsResumable the request is resumable upload
 	IsResumable bool
@@ -40,15 +44,28 @@ type FileOutputData struct {
 
 type FileObjectHandler func(contentHash string, contentSize int64)
 
+// ObjectReader gives random access to the content of a committed object.
+type ObjectReader interface {
+	io.ReadSeeker
+	io.ReaderAt
+	io.Closer
+}
+
 type FileStore interface {
 	WriteFile(allocationID string, fileData *FileInputData, infile multipart.File, connectionID string) (*FileOutputData, error)
 	DeleteTempFile(allocationID string, fileData *FileInputData, connectionID string) error
 	GetFileBlock(allocationID string, fileData *FileInputData, blockNum int64, numBlocks int64) ([]byte, error)
+	// GetFileReader opens the committed object for streaming, returning the
+	// reader and the size of the content. The caller closes the reader.
+	GetFileReader(allocationID string, fileData *FileInputData) (ObjectReader, int64, error)
 	CommitWrite(allocationID string, fileData *FileInputData, connectionID string) (bool, error)
 	//GetMerkleTreeForFile(allocationID string, fileData *FileInputData) (util.MerkleTreeI, error)
 	GetFileBlockForChallenge(allocationID string, fileData *FileInputData, blockoffset int) (json.RawMessage, util.MerkleTreeI, error)
 	DeleteFile(allocationID string, contentHash string) error
 	GetTotalDiskSizeUsed() (int64, error)
+	// GetTotalLogicalSizeUsed returns the size of all the content held, as
+	// if it was neither compressed nor deduplicated.
+	GetTotalLogicalSizeUsed() (int64, error)
 	GetlDiskSizeUsed(allocationID string) (int64, error)
 	GetTempPathSize(allocationID string) (int64, error)
 	IterateObjects(allocationID string, handler FileObjectHandler) error
@@ -57,7 +74,57 @@ type FileStore interface {
 	SetupAllocation(allocationID string, skipCreate bool) (*StoreAllocation, error)
 }
 
-var fsStore FileStore
+// ObjectRestorer is implemented by stores able to replace a committed object
+// with its cloud copy, see UploadToCloud.
+type ObjectRestorer interface {
+	RestoreFromCloud(allocationID string, fileData *FileInputData) error
+}
+
+// ObjectUploader is implemented by stores keeping local objects, which the
+// cold data worker may move to the cloud.
+type ObjectUploader interface {
+	UploadObjectToCloud(allocationID string, fileData *FileInputData) (string, error)
+}
+
+const (
+	LocalStoreBackend  = "local"
+	S3StoreBackend     = "s3"
+	MemoryStoreBackend = "memory"
+)
+
+// StoreFactory creates a FileStore backend rooted at the given directory.
+// Backends that keep their content elsewhere may still use the directory for
+// staging temporary upload files.
+type StoreFactory func(rootDir string) (FileStore, error)
+
+var (
+	fsStore        FileStore
+	storeFactories = make(map[string]StoreFactory)
+)
+
+// RegisterStore makes a FileStore backend available under the given name,
+// replacing any backend previously registered with that name.
+func RegisterStore(name string, factory StoreFactory) {
+	storeFactories[name] = factory
+}
+
+// SetupStore creates the backend registered under the given name and makes it
+// the store returned by GetFileStore.
+func SetupStore(name string, rootDir string) (FileStore, error) {
+	if name == "" {
+		name = LocalStoreBackend
+	}
+	factory, ok := storeFactories[name]
+	if !ok {
+		return nil, common.NewError("invalid_filestore_backend", "Unknown file store backend "+name)
+	}
+	store, err := factory(rootDir)
+	if err != nil {
+		return nil, err
+	}
+	fsStore = store
+	return fsStore, nil
+}
 
 func GetFileStore() FileStore {
 	return fsStore
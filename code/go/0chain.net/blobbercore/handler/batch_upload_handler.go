@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/constants"
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/core/common"
+	"0chain.net/core/lock"
+	. "0chain.net/core/logging"
+
+	"go.uber.org/zap"
+)
+
+/*BatchUploadHandler is the handler to upload many files into a connection at once*/
+func BatchUploadHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.BatchUpload(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchUpload stores the new files of a multipart form into the connection.
+// The uploadMeta field is the JSON list of the metadata of the files, as for
+// a single upload, and the uploadFile parts are the files in the same order.
+// A file failing is reported in its result and left out of the connection,
+// the other ones are added.
+func (fsh *StorageHandler) BatchUpload(ctx context.Context, r *http.Request) (*BatchUploadResult, error) {
+	if r.Method != http.MethodPost {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use multi-part form POST instead")
+	}
+
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, false)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+
+	valid, err := verifySignatureFromRequest(r, allocationObj.OwnerPublicKey)
+	if !valid || err != nil {
+		return nil, common.NewError("invalid_signature", "Invalid signature")
+	}
+
+	allocationID := allocationObj.ID
+
+	if len(clientID) == 0 || (allocationObj.OwnerID != clientID && allocationObj.PayerID != clientID) {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner or the payer of the allocation")
+	}
+
+	quota, err := allocation.NewQuotaReservation(ctx, allocationObj)
+	if err != nil {
+		return nil, err
+	}
+	quota.ReleaseWithTransaction(ctx)
+
+	// fail before receiving files which can't fit, as for a single upload.
+	// The fields and the part headers of every file are allowed over.
+	allowance := UPLOAD_FORM_ALLOWANCE * int64(config.Configuration.MaxBatchUploadFiles)
+	if quota.Available() <= 0 || r.ContentLength > quota.Available()+allowance {
+		return nil, allocation.MaxAllocationSizeReached
+	}
+	r.Body = quota.LimitBody(r.Body, allowance)
+
+	if err := r.ParseMultipartForm(FORM_FILE_PARSE_MAX_MEMORY); err != nil {
+		if quota.Exceeded() {
+			return nil, allocation.MaxAllocationSizeReached
+		}
+		Logger.Info("Error Parsing the request", zap.Any("error", err))
+		return nil, common.NewError("request_parse_error", err.Error())
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	connectionID := r.FormValue("connection_id")
+	if len(connectionID) == 0 {
+		return nil, common.NewError("invalid_parameters", "Invalid connection id passed")
+	}
+
+	var metas []*allocation.NewFileChange
+	if err := json.Unmarshal([]byte(r.FormValue("uploadMeta")), &metas); err != nil {
+		return nil, common.NewError("invalid_parameters",
+			"Invalid parameters. Error parsing the meta data for upload."+err.Error())
+	}
+	files := r.MultipartForm.File["uploadFile"]
+	if len(metas) == 0 || len(metas) != len(files) {
+		return nil, common.NewError("invalid_parameters", "Invalid parameters. Each file needs its meta data")
+	}
+	if len(metas) > config.Configuration.MaxBatchUploadFiles {
+		return nil, common.NewError("invalid_parameters",
+			"Invalid parameters. At most "+strconv.Itoa(config.Configuration.MaxBatchUploadFiles)+" files can be uploaded at once")
+	}
+
+	connectionObj, err := allocation.GetAllocationChanges(ctx, connectionID, allocationID, clientID)
+	if err != nil {
+		return nil, common.NewError("meta_error", "Error reading metadata for connection")
+	}
+
+	mutex := lock.GetMutex(connectionObj.TableName(), connectionID)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	result := &BatchUploadResult{ConnectionID: connectionID,
+		Results: make([]*BatchUploadFileResult, len(metas))}
+	paths := make(map[string]bool, len(metas))
+	added := 0
+	for i, meta := range metas {
+		fileResult := &BatchUploadFileResult{Path: meta.Path}
+		fileResult.Filename = meta.Filename
+		if err := fsh.batchUploadFile(ctx, allocationID, connectionObj, quota, meta, files[i], paths, fileResult); err != nil {
+			fileResult.Error = err.Error()
+		} else {
+			added++
+		}
+		result.Results[i] = fileResult
+	}
+
+	if added == 0 {
+		return result, nil
+	}
+	err = connectionObj.Save(ctx)
+	if err != nil {
+		Logger.Error("Error in writing the connection meta data", zap.Error(err))
+		return nil, common.NewError("connection_write_error", "Error writing the connection meta data")
+	}
+
+	return result, nil
+}
+
+// batchUploadFile stores a file of a batch upload and adds it to the
+// connection.
+func (fsh *StorageHandler) batchUploadFile(ctx context.Context, allocationID string, connectionObj *allocation.AllocationChangeCollector,
+	quota *allocation.QuotaReservation, meta *allocation.NewFileChange, fileHeader *multipart.FileHeader,
+	paths map[string]bool, result *BatchUploadFileResult) error {
+
+	if len(meta.Path) == 0 || !filepath.IsAbs(meta.Path) || len(meta.Filename) == 0 {
+		return common.NewError("invalid_parameters", "Invalid path")
+	}
+	if meta.IsResumable {
+		return common.NewError("invalid_parameters", "Resumable uploads are not supported in a batch")
+	}
+	if paths[meta.Path] || fsh.checkIfFileAlreadyExists(ctx, allocationID, meta.Path) != nil {
+		return common.NewError("duplicate_file", "File at path already exists")
+	}
+	if meta.Size > quota.Available() {
+		return allocation.MaxAllocationSizeReached
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return common.NewError("invalid_parameters", "Error Reading multi parts for file."+err.Error())
+	}
+	defer file.Close()
+
+	fileInputData := &filestore.FileInputData{Name: meta.Filename, Path: meta.Path}
+	fileOutputData, err := filestore.GetFileStore().WriteFile(allocationID, fileInputData, quota.Reader(file), connectionObj.ConnectionID)
+	if err != nil {
+		if quota.Exceeded() {
+			_ = filestore.GetFileStore().DeleteTempFile(allocationID, fileInputData, connectionObj.ConnectionID)
+			return allocation.MaxAllocationSizeReached
+		}
+		return common.NewError("upload_error", "Failed to upload the file. "+err.Error())
+	}
+
+	switch {
+	case len(meta.Hash) > 0 && meta.Hash != fileOutputData.ContentHash:
+		err = common.NewError("content_hash_mismatch", "Content hash provided in the meta data does not match the file content")
+	case len(meta.MerkleRoot) > 0 && meta.MerkleRoot != fileOutputData.MerkleRoot:
+		err = common.NewError("content_merkle_root_mismatch", "Merkle root provided in the meta data does not match the file content")
+	case fileOutputData.Size > config.Configuration.MaxFileSize:
+		err = common.NewError("file_size_limit_exceeded", "Size for the given file is larger than the max limit")
+	}
+	if err != nil {
+		_ = filestore.GetFileStore().DeleteTempFile(allocationID, fileInputData, connectionObj.ConnectionID)
+		return err
+	}
+
+	meta.ConnectionID = connectionObj.ConnectionID
+	meta.AllocationID = allocationID
+	meta.Hash = fileOutputData.ContentHash
+	meta.MerkleRoot = fileOutputData.MerkleRoot
+	meta.Size = fileOutputData.Size
+	meta.ThumbnailHash, meta.ThumbnailSize, meta.ThumbnailFilename = "", 0, ""
+
+	allocationChange := &allocation.AllocationChange{}
+	allocationChange.ConnectionID = connectionObj.ConnectionID
+	allocationChange.Size = fileOutputData.Size
+	allocationChange.Operation = allocation.INSERT_OPERATION
+	connectionObj.Size += allocationChange.Size
+	connectionObj.AddChange(allocationChange, meta)
+	paths[meta.Path] = true
+
+	result.Hash = fileOutputData.ContentHash
+	result.MerkleRoot = fileOutputData.MerkleRoot
+	result.Size = fileOutputData.Size
+	return nil
+}
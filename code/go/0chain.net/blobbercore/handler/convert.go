@@ -3,6 +3,7 @@ package handler
 import (
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/blobbergrpc"
+	"0chain.net/blobbercore/feed"
 	"0chain.net/blobbercore/stats"
 	"0chain.net/blobbercore/writemarker"
 )
@@ -70,3 +71,15 @@ func WriteMarkerToWriteMarkerGRPC(wm writemarker.WriteMarker) *blobbergrpc.Write
 		Signature:              wm.Signature,
 	}
 }
+
+func EventToEventGRPC(event *feed.Event) *blobbergrpc.Event {
+	return &blobbergrpc.Event{
+		ID:             event.ID,
+		AllocationRoot: event.AllocationRoot,
+		Operation:      event.Operation,
+		Path:           event.Path,
+		SrcPath:        event.SrcPath,
+		Hash:           event.Hash,
+		CreatedAt:      event.CreatedAt.UnixNano(),
+	}
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"time"
+
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/readmarker"
 	"0chain.net/blobbercore/reference"
@@ -28,6 +30,34 @@ type CommitResult struct {
 	//Result         []*UploadResult         `json:"result"`
 }
 
+type FileVersionsResult struct {
+	Path     string                   `json:"path,omitempty"`
+	Versions []*reference.FileVersion `json:"versions"`
+}
+
+type FileVersioningResult struct {
+	MaxVersions int   `json:"max_versions"`
+	Retention   int64 `json:"retention"`
+}
+
+type TrashEntry struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Path        string    `json:"path"`
+	Size        int64     `json:"size"`
+	ContentHash string    `json:"content_hash"`
+	MimeType    string    `json:"mimetype"`
+	DeletedAt   time.Time `json:"deleted_at"`
+}
+
+type TrashResult struct {
+	Files []*TrashEntry `json:"files"`
+}
+
+type CollaboratorResult struct {
+	Msg string `json:"msg"`
+}
+
 type ReferencePath struct {
 	Meta map[string]interface{} `json:"meta_data"`
 	List []*ReferencePath       `json:"list,omitempty"`
@@ -48,6 +78,13 @@ type ListResult struct {
 	AllocationRoot string                   `json:"allocation_root"`
 	Meta           map[string]interface{}   `json:"meta_data"`
 	Entities       []map[string]interface{} `json:"list"`
+	NextCursor     string                   `json:"next_cursor,omitempty"`
+}
+
+type SearchResult struct {
+	AllocationRoot string                   `json:"allocation_root"`
+	Entities       []map[string]interface{} `json:"list"`
+	NextCursor     string                   `json:"next_cursor,omitempty"`
 }
 
 type DownloadResponse struct {
@@ -57,3 +94,18 @@ type DownloadResponse struct {
 	Path         string                 `json:"-"`
 	LatestRM     *readmarker.ReadMarker `json:"latest_rm"`
 }
+
+// BatchUploadResult holds the results of the files of a batch upload, in the
+// order of the request.
+type BatchUploadResult struct {
+	ConnectionID string                   `json:"connection_id"`
+	Results      []*BatchUploadFileResult `json:"results"`
+}
+
+// BatchUploadFileResult is the result of a file of a batch upload, the file
+// is not part of the connection if Error is set.
+type BatchUploadFileResult struct {
+	UploadResult
+	Path  string `json:"path"`
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"0chain.net/blobbercore/constants"
+	"0chain.net/blobbercore/feed"
+	"0chain.net/core/common"
+
+	"gorm.io/gorm"
+)
+
+// streamWriteTimeout is the time each write of an event stream is given,
+// instead of the write timeout of the server for the whole response.
+const streamWriteTimeout = 30 * time.Second
+
+// EventStream is the feed of the events of an allocation, streamed to the
+// client as server-sent events, see common.ResponseStreamer.
+type EventStream struct {
+	ctx          context.Context
+	allocationID string
+	afterID      int64
+}
+
+// Watch sends the events of the stream until the client is gone or send
+// fails, see feed.Watch.
+func (es *EventStream) Watch(send func(*feed.Event) error, keepAlive func() error) error {
+	return feed.Watch(es.ctx, es.allocationID, es.afterID, send, keepAlive)
+}
+
+// StreamTo writes the events as server-sent events, with their ids, so that
+// clients reconnect after the last event they received.
+func (es *EventStream) StreamTo(w http.ResponseWriter) error {
+	// the stream outlives the write timeout of the server, its writes get
+	// their own for the stalled clients to be dropped
+	extendDeadline := func() error {
+		return common.SetWriteDeadline(es.ctx, time.Now().Add(streamWriteTimeout))
+	}
+	if err := extendDeadline(); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	flush := func() {
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+	flush()
+
+	send := func(event *feed.Event) error {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if err = extendDeadline(); err != nil {
+			return err
+		}
+		if _, err = fmt.Fprintf(w, "id: %d\ndata: %s\n\n", event.ID, data); err != nil {
+			return err
+		}
+		flush()
+		return nil
+	}
+	keepAlive := func() error {
+		if err := extendDeadline(); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+			return err
+		}
+		flush()
+		return nil
+	}
+	return es.Watch(send, keepAlive)
+}
+
+func (es *EventStream) Close() error {
+	return nil
+}
+
+// WatchEvents returns the feed of the events of the allocation of the owner.
+// It starts after the commit of the "from" allocation root, or after the
+// event of the "last_event_id" form value or Last-Event-ID header, else with
+// the next commit.
+func (fsh *StorageHandler) WatchEvents(ctx context.Context, r *http.Request) (*EventStream, error) {
+	if r.Method != "GET" {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use GET instead")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, true)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+	allocationID := allocationObj.ID
+
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	if len(clientID) == 0 || allocationObj.OwnerID != clientID {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+
+	lastEventID := r.FormValue("last_event_id")
+	if len(lastEventID) == 0 {
+		lastEventID = r.Header.Get("Last-Event-ID")
+	}
+	from := r.FormValue("from")
+
+	var afterID int64
+	switch {
+	case len(lastEventID) > 0:
+		afterID, err = strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil || afterID < 0 {
+			return nil, common.NewError("invalid_parameters", "Invalid last event id passed")
+		}
+	case len(from) > 0:
+		afterID, err = feed.LastEventIDOfRoot(ctx, allocationID, from)
+		if errors.Is(err, gorm.ErrRecordNotFound) && from == allocationObj.AllocationRoot {
+			// committed before the events were kept
+			afterID, err = feed.LastEventID(ctx, allocationID)
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewError("invalid_parameters", "No events of the allocation root "+from)
+		}
+	default:
+		afterID, err = feed.LastEventID(ctx, allocationID)
+	}
+	if err != nil {
+		return nil, common.NewError("event_error", err.Error())
+	}
+
+	return &EventStream{ctx: ctx, allocationID: allocationID, afterID: afterID}, nil
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"0chain.net/blobbercore/constants"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/common"
+)
+
+// GetFileVersions lists the previous versions of a file kept by the
+// allocation, latest first. It is authorized as the file meta is.
+func (fsh *StorageHandler) GetFileVersions(ctx context.Context, r *http.Request) (*FileVersionsResult, error) {
+	if r.Method == "GET" {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use POST instead")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, true)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+	allocationID := allocationObj.ID
+
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	if len(clientID) == 0 {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+
+	pathHash, err := pathHashFromReq(r, allocationID)
+	if err != nil {
+		return nil, err
+	}
+	fileref, err := reference.GetReferenceFromLookupHash(ctx, allocationID, pathHash)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid file path. "+err.Error())
+	}
+	if fileref.Type != reference.FILE {
+		return nil, common.NewError("invalid_parameters", "Path is not a file.")
+	}
+
+	versions, err := reference.GetFileVersions(ctx, fileref.ID)
+	if err != nil {
+		return nil, common.NewError("file_version_error", err.Error())
+	}
+	result := &FileVersionsResult{Path: fileref.Path, Versions: versions}
+
+	authTokenString := r.FormValue("auth_token")
+	if (allocationObj.OwnerID != clientID &&
+		allocationObj.PayerID != clientID &&
+		!reference.IsACollaborator(ctx, fileref.ID, clientID)) || len(authTokenString) > 0 {
+		authTicketVerified, err := fsh.verifyAuthTicket(ctx, authTokenString, allocationObj, fileref, clientID)
+		if err != nil {
+			return nil, err
+		}
+		if !authTicketVerified {
+			return nil, common.NewError("auth_ticket_verification_failed", "Could not verify the auth ticket.")
+		}
+		result.Path = ""
+	}
+
+	return result, nil
+}
+
+// UpdateFileVersioning sets the number of previous versions kept per file
+// and how long they are kept, in seconds, for the allocation of the owner.
+func (fsh *StorageHandler) UpdateFileVersioning(ctx context.Context, r *http.Request) (*FileVersioningResult, error) {
+	if r.Method == "GET" {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use POST instead")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, false)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	if len(clientID) == 0 || allocationObj.OwnerID != clientID {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+	valid, err := verifySignatureFromRequest(r, allocationObj.OwnerPublicKey)
+	if !valid || err != nil {
+		return nil, common.NewError("invalid_signature", "Invalid signature")
+	}
+
+	maxVersions, err := strconv.Atoi(r.FormValue("max_versions"))
+	if err != nil || maxVersions < 0 {
+		return nil, common.NewError("invalid_parameters", "Invalid max versions")
+	}
+	retention, err := strconv.ParseInt(r.FormValue("retention"), 10, 64)
+	if err != nil || retention < 0 {
+		return nil, common.NewError("invalid_parameters", "Invalid retention")
+	}
+
+	if err = allocationObj.SetFileVersioning(ctx, maxVersions, retention); err != nil {
+		return nil, common.NewError("file_version_error", err.Error())
+	}
+	return &FileVersioningResult{MaxVersions: maxVersions, Retention: retention}, nil
+}
+
+// fileVersionFromReq replaces the content of the file reference with the
+// one of the version requested, if any.
+func fileVersionFromReq(ctx context.Context, r *http.Request, fileref *reference.Ref) error {
+	versionStr := r.FormValue("version")
+	if len(versionStr) == 0 {
+		return nil
+	}
+	version, err := strconv.ParseInt(versionStr, 10, 64)
+	if err != nil || version <= 0 {
+		return common.NewError("invalid_parameters", "Invalid version")
+	}
+	fv, err := reference.GetFileVersion(ctx, fileref.ID, version)
+	if err != nil {
+		return common.NewError("invalid_parameters", "No such version of the file")
+	}
+	fileref.ContentHash = fv.ContentHash
+	fileref.MerkleRoot = fv.MerkleRoot
+	fileref.Size = fv.Size
+	fileref.ActualFileHash = fv.ActualFileHash
+	fileref.ActualFileSize = fv.ActualFileSize
+	fileref.ThumbnailHash = fv.ThumbnailHash
+	fileref.ThumbnailSize = fv.ThumbnailSize
+	fileref.MimeType = fv.MimeType
+	fileref.EncryptedKey = fv.EncryptedKey
+	// the cold tiering only moves the current content
+	fileref.OnCloud = false
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"0chain.net/core/common"
 	"0chain.net/core/logging"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	grpc_ratelimit "github.com/grpc-ecosystem/go-grpc-middleware/ratelimit"
@@ -25,14 +26,14 @@ func unaryDatabaseTransactionInjector() grpc.UnaryServerInterceptor {
 		ctx = GetMetaDataStore().CreateTransaction(ctx)
 		resp, err := handler(ctx, req)
 		if err != nil {
-			var rollErr = GetMetaDataStore().GetTransaction(ctx).Rollback().Error
+			var rollErr = GetMetaDataStore().Rollback(ctx)
 			if rollErr != nil {
 				logger.Error("couldn't rollback", zap.Error(err))
 			}
 			return nil, err
 		}
 
-		err = GetMetaDataStore().GetTransaction(ctx).Commit().Error
+		err = GetMetaDataStore().Commit(ctx)
 		if err != nil {
 			return nil, common.NewErrorf("commit_error",
 				"error committing to meta store: %v", err)
@@ -42,6 +43,41 @@ func unaryDatabaseTransactionInjector() grpc.UnaryServerInterceptor {
 	}
 }
 
+// streamsWithoutTransaction are the streams that run for long, which read
+// with transactions of their own rather than holding a connection throughout.
+var streamsWithoutTransaction = map[string]bool{
+	"/blobber.service.v1.Blobber/WatchEvents": true,
+}
+
+func streamDatabaseTransactionInjector() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if streamsWithoutTransaction[info.FullMethod] {
+			return handler(srv, ss)
+		}
+
+		logger := ctxzap.Extract(ss.Context())
+
+		stream := grpc_middleware.WrapServerStream(ss)
+		stream.WrappedContext = GetMetaDataStore().CreateTransaction(ss.Context())
+		err := handler(srv, stream)
+		if err != nil {
+			var rollErr = GetMetaDataStore().Rollback(stream.WrappedContext)
+			if rollErr != nil {
+				logger.Error("couldn't rollback", zap.Error(err))
+			}
+			return err
+		}
+
+		err = GetMetaDataStore().Commit(stream.WrappedContext)
+		if err != nil {
+			return common.NewErrorf("commit_error",
+				"error committing to meta store: %v", err)
+		}
+
+		return nil
+	}
+}
+
 func unaryTimeoutInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		deadline := time.Now().Add(time.Duration(TIMEOUT_SECONDS * time.Second))
@@ -57,6 +93,8 @@ func NewServerWithMiddlewares(limiter grpc_ratelimit.Limiter) *grpc.Server {
 		grpc.ChainStreamInterceptor(
 			grpc_zap.StreamServerInterceptor(logging.Logger),
 			grpc_recovery.StreamServerInterceptor(),
+			streamDatabaseTransactionInjector(),
+			grpc_ratelimit.StreamServerInterceptor(limiter),
 		),
 		grpc.ChainUnaryInterceptor(
 			grpc_zap.UnaryServerInterceptor(logging.Logger),
@@ -1,10 +1,20 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/feed"
 	"0chain.net/blobbercore/writemarker"
 
 	"0chain.net/blobbercore/reference"
@@ -201,9 +211,24 @@ func (b *blobberGRPCService) ListEntities(ctx context.Context, req *blobbergrpc.
 		}
 	}
 
-	dirref, err := b.packageHandler.GetRefWithChildren(ctx, allocationID, fileref.Path)
-	if err != nil {
-		return nil, common.NewError("invalid_parameters", "Invalid path. "+err.Error())
+	var (
+		dirref     *reference.Ref
+		nextCursor string
+	)
+	if len(req.SortBy) > 0 || len(req.Cursor) > 0 || req.Limit != 0 {
+		if req.Limit < 0 {
+			return nil, common.NewError("invalid_parameters", "Invalid limit")
+		}
+		page := &reference.ListPage{SortBy: req.SortBy, Cursor: req.Cursor, Limit: int(req.Limit)}
+		dirref, nextCursor, err = b.packageHandler.GetRefWithChildrenPage(ctx, allocationID, fileref.Path, page)
+		if err != nil {
+			return nil, common.NewError("invalid_parameters", "Invalid path. "+err.Error())
+		}
+	} else {
+		dirref, err = b.packageHandler.GetRefWithChildren(ctx, allocationID, fileref.Path)
+		if err != nil {
+			return nil, common.NewError("invalid_parameters", "Invalid path. "+err.Error())
+		}
 	}
 
 	if clientID != allocationObj.OwnerID {
@@ -223,9 +248,95 @@ func (b *blobberGRPCService) ListEntities(ctx context.Context, req *blobbergrpc.
 	return &blobbergrpc.ListEntitiesResponse{
 		AllocationRoot: allocationObj.AllocationRoot,
 		MetaData:       refGRPC,
+		NextCursor:     nextCursor,
 	}, nil
 }
 
+func (b *blobberGRPCService) SearchEntities(ctx context.Context, req *blobbergrpc.SearchEntitiesRequest) (*blobbergrpc.SearchEntitiesResponse, error) {
+	clientID := req.Context.Client
+	allocationObj, err := b.storageHandler.verifyAllocation(ctx, req.Allocation, true)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+	allocationID := allocationObj.ID
+
+	if len(clientID) == 0 {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+
+	pathHash := req.PathHash
+	if len(pathHash) == 0 {
+		if len(req.Path) == 0 {
+			return nil, common.NewError("invalid_parameters", "Invalid path")
+		}
+		pathHash = reference.GetReferenceLookup(allocationID, req.Path)
+	}
+	dirref, err := b.packageHandler.GetReferenceFromLookupHash(ctx, allocationID, pathHash)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid path. "+err.Error())
+	}
+	if dirref.Type != reference.DIRECTORY {
+		return nil, common.NewError("invalid_parameters", "Path is not a directory.")
+	}
+	if clientID != allocationObj.OwnerID || len(req.AuthToken) > 0 {
+		authTicketVerified, err := b.storageHandler.verifyAuthTicket(ctx, req.AuthToken, allocationObj, dirref, clientID)
+		if err != nil {
+			return nil, err
+		}
+		if !authTicketVerified {
+			return nil, common.NewError("auth_ticket_verification_failed", "Could not verify the auth ticket.")
+		}
+	}
+
+	if req.Type != "" && req.Type != reference.FILE && req.Type != reference.DIRECTORY {
+		return nil, common.NewError("invalid_parameters", "Invalid type")
+	}
+	filter := &reference.SearchFilter{
+		Path:          dirref.Path,
+		Name:          req.Name,
+		MimeType:      req.Mimetype,
+		Type:          req.Type,
+		MinSize:       req.MinSize,
+		MaxSize:       req.MaxSize,
+		CreatedAfter:  unixTime(req.CreatedAfter),
+		CreatedBefore: unixTime(req.CreatedBefore),
+		UpdatedAfter:  unixTime(req.UpdatedAfter),
+		UpdatedBefore: unixTime(req.UpdatedBefore),
+		CustomMeta:    req.CustomMeta,
+		Limit:         int(req.Limit),
+	}
+	if len(req.Cursor) > 0 {
+		filter.After, err = strconv.ParseInt(req.Cursor, 10, 64)
+		if err != nil {
+			return nil, common.NewError("invalid_parameters", "Invalid cursor")
+		}
+	}
+	refs, next, err := b.packageHandler.SearchRefs(ctx, allocationID, filter)
+	if err != nil {
+		return nil, common.NewError("search_error", err.Error())
+	}
+
+	resp := &blobbergrpc.SearchEntitiesResponse{AllocationRoot: allocationObj.AllocationRoot}
+	for _, ref := range refs {
+		if clientID != allocationObj.OwnerID {
+			ref.Path = ""
+		}
+		resp.Entities = append(resp.Entities, reference.FileRefToFileRefGRPC(ref))
+	}
+	if next > 0 {
+		resp.NextCursor = strconv.FormatInt(next, 10)
+	}
+	return resp, nil
+}
+
+// unixTime converts unix seconds to a time, zero for zero seconds.
+func unixTime(secs int64) time.Time {
+	if secs <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(secs, 0).UTC()
+}
+
 func (b *blobberGRPCService) GetObjectPath(ctx context.Context, req *blobbergrpc.GetObjectPathRequest) (*blobbergrpc.GetObjectPathResponse, error) {
 	allocationTx := req.Context.Allocation
 	allocationObj, err := b.storageHandler.verifyAllocation(ctx, allocationTx, false)
@@ -308,7 +419,11 @@ func (b *blobberGRPCService) GetReferencePath(ctx context.Context, req *blobberg
 		}
 	}
 
-	rootRef, err := b.packageHandler.GetReferencePathFromPaths(ctx, allocationID, paths)
+	if req.Depth < 0 {
+		return nil, common.NewError("invalid_parameters", "Invalid depth")
+	}
+
+	rootRef, err := b.packageHandler.GetReferencePathFromPaths(ctx, allocationID, paths, int(req.Depth))
 	if err != nil {
 		return nil, err
 	}
@@ -365,7 +480,11 @@ func (b *blobberGRPCService) GetObjectTree(ctx context.Context, req *blobbergrpc
 		return nil, common.NewError("invalid_parameters", "Invalid path")
 	}
 
-	rootRef, err := b.packageHandler.GetObjectTree(ctx, allocationID, path)
+	if req.Depth < 0 {
+		return nil, common.NewError("invalid_parameters", "Invalid depth")
+	}
+
+	rootRef, err := b.packageHandler.GetObjectTree(ctx, allocationID, path, int(req.Depth))
 	if err != nil {
 		return nil, err
 	}
@@ -402,3 +521,355 @@ func (b *blobberGRPCService) GetObjectTree(ctx context.Context, req *blobbergrpc
 	}
 	return &refPathResult, nil
 }
+
+// grpcDownloadChunkSize is the size of the content chunks of DownloadFile.
+const grpcDownloadChunkSize = 64 * 1024
+
+func (b *blobberGRPCService) UploadFile(stream blobbergrpc.Blobber_UploadFileServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return common.NewError("invalid_parameters", "Error receiving the upload request. "+err.Error())
+	}
+	ctx := setupGRPCWriteContext(stream.Context(), req.Context, req.Allocation)
+
+	var (
+		method    = http.MethodPost
+		metaField = "uploadMeta"
+	)
+	switch req.Method {
+	case "", http.MethodPost:
+	case http.MethodPut:
+		method = http.MethodPut
+		metaField = "updateMeta"
+	default:
+		return common.NewError("invalid_method", "Invalid method used for the upload. Use POST / PUT instead")
+	}
+
+	r := newGRPCRequest(method, req.Context, req.Allocation, nil)
+	r.Form, r.PostForm, r.MultipartForm = nil, nil, nil
+
+	// the content is streamed to the handler as a multipart body
+	body, writer := io.Pipe()
+	defer body.Close()
+	form := multipart.NewWriter(writer)
+	r.Header.Set("Content-Type", form.FormDataContentType())
+	r.Body = body
+	formErr := make(chan error, 1)
+	go func() {
+		err := writeUploadForm(stream, req, form, metaField)
+		writer.CloseWithError(err) //nolint:errcheck
+		formErr <- err
+	}()
+
+	result, err := b.storageHandler.WriteFile(ctx, r)
+	// the handler may not read the whole form, the writer is stopped and
+	// waited for, not to receive from the stream once the call returned
+	body.Close()
+	if cerr, ok := (<-formErr).(*common.Error); ok {
+		// the form was refused, the handler failed reading it
+		return cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&blobbergrpc.UploadFileResponse{
+		Filename:    result.Filename,
+		Size:        result.Size,
+		ContentHash: result.Hash,
+		MerkleRoot:  result.MerkleRoot,
+	})
+}
+
+// writeUploadForm writes the multipart form of an upload, with the content
+// received over the stream. The thumbnail is small, it is held in memory to
+// be written after the file, up to the max thumbnail size.
+func writeUploadForm(stream blobbergrpc.Blobber_UploadFileServer, req *blobbergrpc.UploadFileRequest, form *multipart.Writer, metaField string) error {
+	if err := form.WriteField("connection_id", req.ConnectionId); err != nil {
+		return err
+	}
+	if err := form.WriteField(metaField, req.UploadMeta); err != nil {
+		return err
+	}
+	file, err := form.CreateFormFile("uploadFile", "uploadFile")
+	if err != nil {
+		return err
+	}
+
+	var (
+		thumbnail         bytes.Buffer
+		thumbnailFilename = req.ThumbnailFilename
+	)
+	for {
+		if _, err := file.Write(req.UploadFile); err != nil {
+			return err
+		}
+		if int64(thumbnail.Len()+len(req.UploadThumbnailFile)) > config.Configuration.MaxThumbnailSize {
+			return common.NewError("thumbnail_size_limit_exceeded",
+				"Size for the given thumbnail is larger than the max limit")
+		}
+		thumbnail.Write(req.UploadThumbnailFile)
+
+		req, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if thumbnail.Len() > 0 {
+		thumbFile, err := form.CreateFormFile("uploadThumbnailFile", thumbnailFilename)
+		if err != nil {
+			return err
+		}
+		if _, err := thumbnail.WriteTo(thumbFile); err != nil {
+			return err
+		}
+	}
+	return form.Close()
+}
+
+func (b *blobberGRPCService) DownloadFile(req *blobbergrpc.DownloadFileRequest, stream blobbergrpc.Blobber_DownloadFileServer) error {
+	ctx := setupGRPCWriteContext(stream.Context(), req.Context, req.Allocation)
+
+	form := url.Values{
+		"path":        {req.Path},
+		"path_hash":   {req.PathHash},
+		"auth_token":  {req.AuthToken},
+		"rx_pay":      {strconv.FormatBool(req.RxPay)},
+		"read_marker": {req.ReadMarker},
+		"content":     {req.Content},
+	}
+	r := newGRPCRequest(http.MethodGet, req.Context, req.Allocation, form)
+	if req.Offset < 0 || req.Length < 0 {
+		return common.NewError("invalid_parameters", "Invalid range")
+	}
+	if req.Offset > 0 || req.Length > 0 {
+		rangeHeader := fmt.Sprintf("bytes=%d-", req.Offset)
+		if req.Length > 0 {
+			rangeHeader += strconv.FormatInt(req.Offset+req.Length-1, 10)
+		}
+		r.Header.Set("Range", rangeHeader)
+	}
+
+	resp, err := b.storageHandler.StreamFile(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	switch resp := resp.(type) {
+	case *DownloadResponse:
+		latestRM, err := json.Marshal(resp.LatestRM)
+		if err != nil {
+			return common.NewError("download_file", err.Error())
+		}
+		return stream.Send(&blobbergrpc.DownloadFileResponse{
+			Success:  resp.Success,
+			LatestRM: string(latestRM),
+		})
+	case *StreamResponse:
+		defer resp.Close()
+		if resp.Status == http.StatusRequestedRangeNotSatisfiable {
+			return common.NewError("invalid_parameters", "Range not satisfiable, content size "+
+				strings.TrimPrefix(resp.Header.Get("Content-Range"), "bytes */"))
+		}
+		if resp.body == nil {
+			return stream.Send(&blobbergrpc.DownloadFileResponse{Success: true})
+		}
+		size, _ := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64)
+		var (
+			chunk = make([]byte, grpcDownloadChunkSize)
+			sent  bool
+		)
+		for {
+			n, err := io.ReadFull(resp.body, chunk)
+			if n > 0 || !sent {
+				sendErr := stream.Send(&blobbergrpc.DownloadFileResponse{
+					Success: true,
+					Data:    chunk[:n],
+					Size:    size,
+				})
+				if sendErr != nil {
+					return sendErr
+				}
+				sent = true
+			}
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return nil
+			}
+			if err != nil {
+				return common.NewError("download_file", err.Error())
+			}
+		}
+	default:
+		return common.NewError("download_file", "unexpected download response")
+	}
+}
+
+// WatchEvents streams the events of the changes committed to the allocation.
+// The stream runs without the transaction of the other calls, the events are
+// read with transactions of their own.
+func (b *blobberGRPCService) WatchEvents(req *blobbergrpc.WatchEventsRequest, stream blobbergrpc.Blobber_WatchEventsServer) error {
+	ctx := setupGRPCWriteContext(stream.Context(), req.Context, req.Allocation)
+	ctx = GetMetaDataStore().CreateTransaction(ctx)
+
+	form := url.Values{"from": {req.From}}
+	if req.LastEventId != 0 {
+		form.Set("last_event_id", strconv.FormatInt(req.LastEventId, 10))
+	}
+	r := newGRPCRequest(http.MethodGet, req.Context, req.Allocation, form)
+	events, err := b.storageHandler.WatchEvents(ctx, r)
+	GetMetaDataStore().GetTransaction(ctx).Rollback()
+	if err != nil {
+		return err
+	}
+
+	return events.Watch(func(event *feed.Event) error {
+		return stream.Send(EventToEventGRPC(event))
+	}, nil)
+}
+
+func (b *blobberGRPCService) RenameObject(ctx context.Context, req *blobbergrpc.RenameObjectRequest) (*blobbergrpc.RenameObjectResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+
+	r := newGRPCRequest(http.MethodPost, req.Context, req.Allocation, url.Values{
+		"path":          {req.Path},
+		"path_hash":     {req.PathHash},
+		"new_name":      {req.NewName},
+		"connection_id": {req.ConnectionId},
+	})
+	resp, err := b.storageHandler.RenameObject(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.(*UploadResult)
+	return &blobbergrpc.RenameObjectResponse{
+		Filename:    result.Filename,
+		Size:        result.Size,
+		ContentHash: result.Hash,
+		MerkleRoot:  result.MerkleRoot,
+	}, nil
+}
+
+func (b *blobberGRPCService) CopyObject(ctx context.Context, req *blobbergrpc.CopyObjectRequest) (*blobbergrpc.CopyObjectResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+
+	r := newGRPCRequest(http.MethodPost, req.Context, req.Allocation, url.Values{
+		"path":          {req.Path},
+		"path_hash":     {req.PathHash},
+		"dest":          {req.Dest},
+		"connection_id": {req.ConnectionId},
+	})
+	resp, err := b.storageHandler.CopyObject(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.(*UploadResult)
+	return &blobbergrpc.CopyObjectResponse{
+		Filename:    result.Filename,
+		Size:        result.Size,
+		ContentHash: result.Hash,
+		MerkleRoot:  result.MerkleRoot,
+	}, nil
+}
+
+func (b *blobberGRPCService) DeleteFile(ctx context.Context, req *blobbergrpc.DeleteFileRequest) (*blobbergrpc.DeleteFileResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+
+	r := newGRPCRequest(http.MethodDelete, req.Context, req.Allocation, url.Values{
+		"path":          {req.Path},
+		"connection_id": {req.ConnectionId},
+	})
+	result, err := b.storageHandler.WriteFile(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blobbergrpc.DeleteFileResponse{
+		Filename:    result.Filename,
+		Size:        result.Size,
+		ContentHash: result.Hash,
+		MerkleRoot:  result.MerkleRoot,
+	}, nil
+}
+
+func (b *blobberGRPCService) UpdateObjectAttributes(ctx context.Context, req *blobbergrpc.UpdateObjectAttributesRequest) (*blobbergrpc.UpdateObjectAttributesResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+
+	r := newGRPCRequest(http.MethodPost, req.Context, req.Allocation, url.Values{
+		"path":          {req.Path},
+		"path_hash":     {req.PathHash},
+		"connection_id": {req.ConnectionId},
+		"attributes":    {req.Attributes},
+	})
+	resp, err := b.storageHandler.UpdateObjectAttributes(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := resp.(*reference.Attributes)
+	return &blobbergrpc.UpdateObjectAttributesResponse{
+		WhoPaysForReads: int64(attrs.WhoPaysForReads),
+	}, nil
+}
+
+func (b *blobberGRPCService) CommitWrite(ctx context.Context, req *blobbergrpc.CommitWriteRequest) (*blobbergrpc.CommitWriteResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+
+	r := newGRPCRequest(http.MethodPost, req.Context, req.Allocation, url.Values{
+		"connection_id": {req.ConnectionId},
+		"write_marker":  {req.WriteMarker},
+	})
+	result, err := b.storageHandler.CommitWrite(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blobbergrpc.CommitWriteResponse{
+		AllocationRoot: result.AllocationRoot,
+		Success:        result.Success,
+		ErrorMessage:   result.ErrorMessage,
+	}
+	if result.WriteMarker != nil {
+		resp.WriteMarker = WriteMarkerToWriteMarkerGRPC(*result.WriteMarker)
+	}
+	return resp, nil
+}
+
+func (b *blobberGRPCService) AddCollaborator(ctx context.Context, req *blobbergrpc.AddCollaboratorRequest) (*blobbergrpc.AddCollaboratorResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+
+	r := newGRPCRequest(http.MethodPost, req.Context, req.Allocation, url.Values{
+		"path":      {req.Path},
+		"path_hash": {req.PathHash},
+		"collab_id": {req.CollabId},
+	})
+	resp, err := b.storageHandler.AddCollaborator(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.(CollaboratorResult)
+	return &blobbergrpc.AddCollaboratorResponse{Message: result.Msg}, nil
+}
+
+func (b *blobberGRPCService) CalculateHash(ctx context.Context, req *blobbergrpc.CalculateHashRequest) (*blobbergrpc.CalculateHashResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+
+	r := newGRPCRequest(http.MethodPost, req.Context, req.Allocation, url.Values{
+		"path":  {req.Path},
+		"paths": {req.Paths},
+	})
+	resp, err := b.storageHandler.CalculateHash(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.(map[string]interface{})
+	msg, _ := result["msg"].(string)
+	return &blobbergrpc.CalculateHashResponse{Message: msg}, nil
+}
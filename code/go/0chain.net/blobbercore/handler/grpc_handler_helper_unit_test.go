@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"net/http"
 
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/reference"
@@ -56,3 +57,78 @@ func (_m *storageHandlerI) verifyAuthTicket(ctx context.Context, authTokenString
 
 	return r0, r1
 }
+
+// WriteFile provides a mock function with given fields: ctx, r
+func (_m *storageHandlerI) WriteFile(ctx context.Context, r *http.Request) (*UploadResult, error) {
+	ret := _m.Called(ctx, r)
+
+	var r0 *UploadResult
+	if rf, ok := ret.Get(0).(func(context.Context, *http.Request) *UploadResult); ok {
+		r0 = rf(ctx, r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UploadResult)
+		}
+	}
+
+	return r0, ret.Error(1)
+}
+
+// StreamFile provides a mock function with given fields: ctx, r
+func (_m *storageHandlerI) StreamFile(ctx context.Context, r *http.Request) (interface{}, error) {
+	return _m.calledWithRequest(ctx, "StreamFile", r)
+}
+
+// RenameObject provides a mock function with given fields: ctx, r
+func (_m *storageHandlerI) RenameObject(ctx context.Context, r *http.Request) (interface{}, error) {
+	return _m.calledWithRequest(ctx, "RenameObject", r)
+}
+
+// CopyObject provides a mock function with given fields: ctx, r
+func (_m *storageHandlerI) CopyObject(ctx context.Context, r *http.Request) (interface{}, error) {
+	return _m.calledWithRequest(ctx, "CopyObject", r)
+}
+
+// UpdateObjectAttributes provides a mock function with given fields: ctx, r
+func (_m *storageHandlerI) UpdateObjectAttributes(ctx context.Context, r *http.Request) (interface{}, error) {
+	return _m.calledWithRequest(ctx, "UpdateObjectAttributes", r)
+}
+
+// CommitWrite provides a mock function with given fields: ctx, r
+func (_m *storageHandlerI) CommitWrite(ctx context.Context, r *http.Request) (*CommitResult, error) {
+	ret := _m.Called(ctx, r)
+
+	var r0 *CommitResult
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*CommitResult)
+	}
+
+	return r0, ret.Error(1)
+}
+
+// AddCollaborator provides a mock function with given fields: ctx, r
+func (_m *storageHandlerI) AddCollaborator(ctx context.Context, r *http.Request) (interface{}, error) {
+	return _m.calledWithRequest(ctx, "AddCollaborator", r)
+}
+
+// CalculateHash provides a mock function with given fields: ctx, r
+func (_m *storageHandlerI) CalculateHash(ctx context.Context, r *http.Request) (interface{}, error) {
+	return _m.calledWithRequest(ctx, "CalculateHash", r)
+}
+
+// WatchEvents provides a mock function with given fields: ctx, r
+func (_m *storageHandlerI) WatchEvents(ctx context.Context, r *http.Request) (*EventStream, error) {
+	ret := _m.Called(ctx, r)
+
+	var r0 *EventStream
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*EventStream)
+	}
+
+	return r0, ret.Error(1)
+}
+
+func (_m *storageHandlerI) calledWithRequest(ctx context.Context, methodName string, r *http.Request) (interface{}, error) {
+	ret := _m.MethodCalled(methodName, ctx, r)
+	return ret.Get(0), ret.Error(1)
+}
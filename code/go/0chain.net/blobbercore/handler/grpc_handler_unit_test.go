@@ -1,10 +1,25 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
 	"testing"
 
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/constants"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/feed"
+	"0chain.net/core/common"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
 	"0chain.net/blobbercore/stats"
 
 	"0chain.net/blobbercore/reference"
@@ -284,6 +299,45 @@ func TestBlobberGRPCService_ListEntities_InvalidAuthTicket(t *testing.T) {
 	}
 }
 
+func TestBlobberGRPCService_ListEntities_Page(t *testing.T) {
+	req := &blobbergrpc.ListEntitiesRequest{
+		Context: &blobbergrpc.RequestContext{
+			Client: "owner",
+		},
+		Path:   "/dir",
+		SortBy: reference.SortByUpdatedAt,
+		Cursor: "cursor",
+		Limit:  1,
+	}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockReferencePackage := &mocks.PackageHandler{}
+	mockStorageHandler.On("verifyAllocation", mock.Anything, req.Allocation, true).Return(&allocation.Allocation{
+		ID:             "allocationId",
+		OwnerID:        "owner",
+		AllocationRoot: "/allocationroot",
+	}, nil)
+	mockReferencePackage.On("GetReferenceFromLookupHash", mock.Anything, mock.Anything, mock.Anything).Return(&reference.Ref{
+		Path: "/dir",
+		Type: reference.DIRECTORY,
+	}, nil)
+	page := &reference.ListPage{SortBy: reference.SortByUpdatedAt, Cursor: "cursor", Limit: 1}
+	mockReferencePackage.On("GetRefWithChildrenPage", mock.Anything, "allocationId", "/dir", page).Return(&reference.Ref{
+		Path:     "/dir",
+		Type:     reference.DIRECTORY,
+		Children: []*reference.Ref{{Name: "a", Path: "/dir/a", Type: reference.FILE}},
+	}, "next", nil)
+
+	svc := newGRPCBlobberService(mockStorageHandler, mockReferencePackage)
+	resp, err := svc.ListEntities(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, "next", resp.NextCursor)
+	require.Len(t, resp.MetaData.DirMetaData.Children, 1)
+	assert.Equal(t, "/dir/a", resp.MetaData.DirMetaData.Children[0].FileMetaData.Path)
+	mockReferencePackage.AssertNotCalled(t, "GetRefWithChildren", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestBlobberGRPCService_GetObjectPath_Success(t *testing.T) {
 	req := &blobbergrpc.GetObjectPathRequest{
 		Context: &blobbergrpc.RequestContext{
@@ -365,7 +419,7 @@ func TestBlobberGRPCService_GetReferencePath_Success(t *testing.T) {
 		Tx:      req.Allocation,
 		OwnerID: "owner",
 	}, nil)
-	mockReferencePackage.On("GetReferencePathFromPaths", mock.Anything, mock.Anything, mock.Anything).Return(&reference.Ref{
+	mockReferencePackage.On("GetReferencePathFromPaths", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&reference.Ref{
 		Name:     "test",
 		Type:     reference.DIRECTORY,
 		Children: []*reference.Ref{{Name: "test1", Type: reference.FILE}},
@@ -400,7 +454,7 @@ func TestBlobberGRPCService_GetReferencePath_InvalidPaths(t *testing.T) {
 		Tx:      req.Allocation,
 		OwnerID: "owner",
 	}, nil)
-	mockReferencePackage.On("GetReferencePathFromPaths", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("invalid paths"))
+	mockReferencePackage.On("GetReferencePathFromPaths", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("invalid paths"))
 
 	svc := newGRPCBlobberService(mockStorageHandler, mockReferencePackage)
 	_, err := svc.GetReferencePath(context.Background(), req)
@@ -430,7 +484,7 @@ func TestBlobberGRPCService_GetObjectTree_Success(t *testing.T) {
 		Tx:      req.Allocation,
 		OwnerID: "owner",
 	}, nil)
-	mockReferencePackage.On("GetObjectTree", mock.Anything, mock.Anything, mock.Anything).Return(&reference.Ref{
+	mockReferencePackage.On("GetObjectTree", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&reference.Ref{
 		Name:     "test",
 		Type:     reference.DIRECTORY,
 		Children: []*reference.Ref{{Name: "test1", Type: reference.FILE}},
@@ -472,3 +526,343 @@ func TestBlobberGRPCService_GetObjectTree_NotOwner(t *testing.T) {
 	}
 
 }
+
+func TestBlobberGRPCService_RenameObject_Success(t *testing.T) {
+	req := &blobbergrpc.RenameObjectRequest{
+		Context: &blobbergrpc.RequestContext{
+			Client:          "owner",
+			ClientKey:       "key",
+			ClientSignature: "signature",
+		},
+		Allocation:   "allocation tx",
+		Path:         "/old",
+		NewName:      "new",
+		ConnectionId: "connection",
+	}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockReferencePackage := &mocks.PackageHandler{}
+	mockStorageHandler.On("RenameObject", mock.Anything, mock.Anything).Return(&UploadResult{
+		Filename: "new",
+		Size:     10,
+		Hash:     "hash",
+	}, nil).Run(func(args mock.Arguments) {
+		ctx := args.Get(0).(context.Context)
+		r := args.Get(1).(*http.Request)
+		assert.Equal(t, "allocation tx", ctx.Value(constants.ALLOCATION_CONTEXT_KEY))
+		assert.Equal(t, "owner", ctx.Value(constants.CLIENT_CONTEXT_KEY))
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "signature", r.Header.Get(common.ClientSignatureHeader))
+		assert.Equal(t, "allocation tx", mux.Vars(r)["allocation"])
+		assert.NoError(t, r.ParseMultipartForm(FORM_FILE_PARSE_MAX_MEMORY))
+		assert.Equal(t, "/old", r.FormValue("path"))
+		assert.Equal(t, "new", r.FormValue("new_name"))
+		assert.Equal(t, "connection", r.FormValue("connection_id"))
+	})
+
+	svc := newGRPCBlobberService(mockStorageHandler, mockReferencePackage)
+	resp, err := svc.RenameObject(context.Background(), req)
+	require.NoError(t, err)
+	mockStorageHandler.AssertExpectations(t)
+	assert.Equal(t, "new", resp.Filename)
+	assert.Equal(t, int64(10), resp.Size)
+	assert.Equal(t, "hash", resp.ContentHash)
+}
+
+func TestBlobberGRPCService_DeleteFile_Error(t *testing.T) {
+	req := &blobbergrpc.DeleteFileRequest{
+		Context:    &blobbergrpc.RequestContext{Client: "owner"},
+		Allocation: "allocation tx",
+		Path:       "/file",
+	}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockReferencePackage := &mocks.PackageHandler{}
+	mockStorageHandler.On("WriteFile", mock.Anything, mock.MatchedBy(func(r *http.Request) bool {
+		return r.Method == http.MethodDelete && r.FormValue("path") == "/file"
+	})).Return(nil, common.NewError("invalid_file", "File does not exist at path"))
+
+	svc := newGRPCBlobberService(mockStorageHandler, mockReferencePackage)
+	_, err := svc.DeleteFile(context.Background(), req)
+	assert.EqualError(t, err, "invalid_file: File does not exist at path")
+}
+
+type uploadFileServer struct {
+	grpc.ServerStream
+	requests []*blobbergrpc.UploadFileRequest
+	response *blobbergrpc.UploadFileResponse
+}
+
+func (s *uploadFileServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *uploadFileServer) Recv() (*blobbergrpc.UploadFileRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *uploadFileServer) SendAndClose(resp *blobbergrpc.UploadFileResponse) error {
+	s.response = resp
+	return nil
+}
+
+func TestBlobberGRPCService_UploadFile_Success(t *testing.T) {
+	defer func(max int64) { config.Configuration.MaxThumbnailSize = max }(config.Configuration.MaxThumbnailSize)
+	config.Configuration.MaxThumbnailSize = 1024
+	stream := &uploadFileServer{requests: []*blobbergrpc.UploadFileRequest{
+		{
+			Context:           &blobbergrpc.RequestContext{Client: "owner"},
+			Allocation:        "allocation tx",
+			ConnectionId:      "connection",
+			Method:            http.MethodPut,
+			UploadMeta:        `{"filename":"file"}`,
+			ThumbnailFilename: "thumb",
+			UploadFile:        []byte("first "),
+		},
+		{UploadFile: []byte("second"), UploadThumbnailFile: []byte("thumbnail")},
+	}}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockReferencePackage := &mocks.PackageHandler{}
+	mockStorageHandler.On("WriteFile", mock.Anything, mock.Anything).Return(func(ctx context.Context, r *http.Request) *UploadResult {
+		assert.Equal(t, http.MethodPut, r.Method)
+		require.NoError(t, r.ParseMultipartForm(FORM_FILE_PARSE_MAX_MEMORY))
+		assert.Equal(t, "connection", r.FormValue("connection_id"))
+		assert.Equal(t, `{"filename":"file"}`, r.FormValue("updateMeta"))
+
+		file, _, err := r.FormFile("uploadFile")
+		require.NoError(t, err)
+		content, _ := ioutil.ReadAll(file)
+		assert.Equal(t, "first second", string(content))
+
+		thumb, header, err := r.FormFile("uploadThumbnailFile")
+		require.NoError(t, err)
+		content, _ = ioutil.ReadAll(thumb)
+		assert.Equal(t, "thumbnail", string(content))
+		assert.Equal(t, "thumb", header.Filename)
+
+		return &UploadResult{Filename: "file", Size: 12}
+	}, nil)
+
+	svc := newGRPCBlobberService(mockStorageHandler, mockReferencePackage)
+	require.NoError(t, svc.UploadFile(stream))
+	assert.Equal(t, "file", stream.response.Filename)
+	assert.Equal(t, int64(12), stream.response.Size)
+}
+
+func TestBlobberGRPCService_UploadFile_ThumbnailTooLarge(t *testing.T) {
+	defer func(max int64) { config.Configuration.MaxThumbnailSize = max }(config.Configuration.MaxThumbnailSize)
+	config.Configuration.MaxThumbnailSize = 8
+	stream := &uploadFileServer{requests: []*blobbergrpc.UploadFileRequest{
+		{
+			Context:      &blobbergrpc.RequestContext{Client: "owner"},
+			Allocation:   "allocation tx",
+			ConnectionId: "connection",
+			UploadMeta:   `{"filename":"file"}`,
+			UploadFile:   []byte("content"),
+		},
+		{UploadThumbnailFile: []byte("thumb")},
+		{UploadThumbnailFile: []byte("nail")},
+		{UploadFile: []byte("never received")},
+	}}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockReferencePackage := &mocks.PackageHandler{}
+	mockStorageHandler.On("WriteFile", mock.Anything, mock.Anything).Return(func(ctx context.Context, r *http.Request) *UploadResult {
+		assert.Error(t, r.ParseMultipartForm(FORM_FILE_PARSE_MAX_MEMORY))
+		return nil
+	}, common.NewError("request_parse_error", "the form is incomplete"))
+
+	svc := newGRPCBlobberService(mockStorageHandler, mockReferencePackage)
+	err := svc.UploadFile(stream)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "thumbnail_size_limit_exceeded")
+	assert.Len(t, stream.requests, 1)
+}
+
+type downloadFileServer struct {
+	grpc.ServerStream
+	responses []*blobbergrpc.DownloadFileResponse
+}
+
+func (s *downloadFileServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *downloadFileServer) Send(resp *blobbergrpc.DownloadFileResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func TestBlobberGRPCService_DownloadFile_Success(t *testing.T) {
+	req := &blobbergrpc.DownloadFileRequest{
+		Context:    &blobbergrpc.RequestContext{Client: "reader"},
+		Allocation: "allocation tx",
+		Path:       "/file",
+		Offset:     10,
+		Length:     grpcDownloadChunkSize + 1,
+	}
+	content := bytes.Repeat([]byte{1}, grpcDownloadChunkSize+1)
+
+	mockStorageHandler := &storageHandlerI{}
+	mockReferencePackage := &mocks.PackageHandler{}
+	mockStorageHandler.On("StreamFile", mock.Anything, mock.MatchedBy(func(r *http.Request) bool {
+		return r.Method == http.MethodGet &&
+			r.Header.Get("Range") == fmt.Sprintf("bytes=10-%d", 10+grpcDownloadChunkSize)
+	})).Return(&StreamResponse{
+		Status: http.StatusPartialContent,
+		Header: http.Header{"Content-Length": {strconv.Itoa(len(content))}},
+		body:   bytes.NewReader(content),
+	}, nil)
+
+	svc := newGRPCBlobberService(mockStorageHandler, mockReferencePackage)
+	stream := &downloadFileServer{}
+	require.NoError(t, svc.DownloadFile(req, stream))
+
+	require.Len(t, stream.responses, 2)
+	var received []byte
+	for _, resp := range stream.responses {
+		assert.True(t, resp.Success)
+		assert.Equal(t, int64(len(content)), resp.Size)
+		received = append(received, resp.Data...)
+	}
+	assert.Equal(t, content, received)
+}
+
+type watchEventsServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel func()
+	events []*blobbergrpc.Event
+}
+
+func (s *watchEventsServer) Context() context.Context {
+	return s.ctx
+}
+
+// Send stops the stream once two events are received.
+func (s *watchEventsServer) Send(event *blobbergrpc.Event) error {
+	s.events = append(s.events, event)
+	if len(s.events) == 2 {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestBlobberGRPCService_WatchEvents(t *testing.T) {
+	datastore.OpenTheSQLiteStore(t)
+	defer datastore.GetStore().Close()
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	require.NoError(t, feed.AddEvents(ctx, "allocationId", []*feed.Event{
+		{AllocationRoot: "root1", Operation: allocation.INSERT_OPERATION, Path: "/a"},
+		{AllocationRoot: "root2", Operation: allocation.DELETE_OPERATION, Path: "/b"},
+		{AllocationRoot: "root2", Operation: allocation.DELETE_OPERATION, Path: "/c"},
+	}))
+	require.NoError(t, datastore.GetStore().Commit(ctx))
+
+	req := &blobbergrpc.WatchEventsRequest{
+		Context:     &blobbergrpc.RequestContext{Client: "owner"},
+		Allocation:  "allocation tx",
+		LastEventId: 1,
+	}
+	stream := &watchEventsServer{}
+	stream.ctx, stream.cancel = context.WithCancel(context.Background())
+
+	mockStorageHandler := &storageHandlerI{}
+	mockReferencePackage := &mocks.PackageHandler{}
+	mockStorageHandler.On("WatchEvents", mock.Anything, mock.MatchedBy(func(r *http.Request) bool {
+		return r.Method == http.MethodGet && r.FormValue("last_event_id") == "1"
+	})).Return(&EventStream{ctx: stream.ctx, allocationID: "allocationId", afterID: 1}, nil)
+
+	svc := newGRPCBlobberService(mockStorageHandler, mockReferencePackage)
+	require.NoError(t, svc.WatchEvents(req, stream))
+
+	require.Len(t, stream.events, 2)
+	assert.Equal(t, "/b", stream.events[0].Path)
+	assert.Equal(t, "/c", stream.events[1].Path)
+	assert.Equal(t, "root2", stream.events[1].AllocationRoot)
+	assert.Equal(t, allocation.DELETE_OPERATION, stream.events[1].Operation)
+}
+
+func TestBlobberGRPCService_SearchEntities_Success(t *testing.T) {
+	req := &blobbergrpc.SearchEntitiesRequest{
+		Context: &blobbergrpc.RequestContext{
+			Client: "client",
+		},
+		Allocation:   "alloc",
+		Path:         "/photos",
+		AuthToken:    "something",
+		Name:         "*.jpg",
+		Mimetype:     "image/*",
+		MinSize:      10,
+		CreatedAfter: 1600000000,
+		Cursor:       "42",
+		Limit:        2,
+	}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockReferencePackage := &mocks.PackageHandler{}
+	mockStorageHandler.On("verifyAllocation", mock.Anything, req.Allocation, true).Return(&allocation.Allocation{
+		ID:             "allocationId",
+		Tx:             req.Allocation,
+		OwnerID:        "owner",
+		AllocationRoot: "/allocationroot",
+	}, nil)
+	mockReferencePackage.On("GetReferenceFromLookupHash", mock.Anything, "allocationId",
+		reference.GetReferenceLookup("allocationId", "/photos")).Return(&reference.Ref{
+		Name: "photos",
+		Path: "/photos",
+		Type: reference.DIRECTORY,
+	}, nil)
+	mockStorageHandler.On("verifyAuthTicket", mock.Anything, req.AuthToken, mock.Anything, mock.Anything, "client").Return(true, nil)
+	mockReferencePackage.On("SearchRefs", mock.Anything, "allocationId", mock.MatchedBy(func(f *reference.SearchFilter) bool {
+		return f.Path == "/photos" && f.Name == "*.jpg" && f.MimeType == "image/*" &&
+			f.MinSize == 10 && f.CreatedAfter.Unix() == 1600000000 &&
+			f.After == 42 && f.Limit == 2
+	})).Return([]*reference.Ref{
+		{ID: 43, Name: "a.jpg", Path: "/photos/a.jpg", Type: reference.FILE},
+		{ID: 50, Name: "b.jpg", Path: "/photos/b.jpg", Type: reference.FILE},
+	}, int64(50), nil)
+
+	svc := newGRPCBlobberService(mockStorageHandler, mockReferencePackage)
+	resp, err := svc.SearchEntities(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, "/allocationroot", resp.AllocationRoot)
+	assert.Equal(t, "50", resp.NextCursor)
+	require.Len(t, resp.Entities, 2)
+	assert.Equal(t, "a.jpg", resp.Entities[0].FileMetaData.Name)
+	// the paths are hidden from the ones other than the owner
+	assert.Empty(t, resp.Entities[0].FileMetaData.Path)
+}
+
+func TestBlobberGRPCService_SearchEntities_NotDirectory(t *testing.T) {
+	req := &blobbergrpc.SearchEntitiesRequest{
+		Context: &blobbergrpc.RequestContext{
+			Client: "owner",
+		},
+		Allocation: "alloc",
+		Path:       "/file",
+	}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockReferencePackage := &mocks.PackageHandler{}
+	mockStorageHandler.On("verifyAllocation", mock.Anything, req.Allocation, true).Return(&allocation.Allocation{
+		ID:      "allocationId",
+		OwnerID: "owner",
+	}, nil)
+	mockReferencePackage.On("GetReferenceFromLookupHash", mock.Anything, mock.Anything, mock.Anything).Return(&reference.Ref{
+		Name: "file",
+		Type: reference.FILE,
+	}, nil)
+
+	svc := newGRPCBlobberService(mockStorageHandler, mockReferencePackage)
+	_, err := svc.SearchEntities(context.Background(), req)
+	require.Error(t, err)
+	mockReferencePackage.AssertNotCalled(t, "SearchRefs", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -12,12 +12,17 @@ import (
 	"net/http"
 	"os"
 	"runtime/pprof"
+	"strconv"
+	"time"
 
+	"0chain.net/blobbercore/challenge"
 	"0chain.net/blobbercore/config"
 	"0chain.net/blobbercore/constants"
 	"0chain.net/blobbercore/datastore"
 	"0chain.net/blobbercore/stats"
+	"0chain.net/blobbercore/webhook"
 	"0chain.net/core/common"
+	"0chain.net/core/metrics"
 	"0chain.net/core/node"
 
 	"github.com/gorilla/mux"
@@ -34,31 +39,52 @@ func GetMetaDataStore() *datastore.Store {
 
 /*SetupHandlers sets up the necessary API end points */
 func SetupHandlers(r *mux.Router) {
+	r.Use(metrics.Middleware)
+
 	//object operations
 	r.HandleFunc("/v1/file/upload/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(UploadHandler))))
+	r.HandleFunc("/v1/file/upload/batch/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(BatchUploadHandler))))
 	r.HandleFunc("/v1/file/download/{allocation}", common.UserRateLimit(common.ToByteStream(WithConnection(DownloadHandler))))
+	r.HandleFunc("/v1/file/stream/{allocation}", common.UserRateLimit(common.ToByteStream(WithConnection(StreamHandler))))
 	r.HandleFunc("/v1/file/rename/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(RenameHandler))))
 	r.HandleFunc("/v1/file/copy/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(CopyHandler))))
+	r.HandleFunc("/v1/file/move/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(MoveHandler))))
+	r.HandleFunc("/v1/dir/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(CreateDirHandler))))
 	r.HandleFunc("/v1/file/attributes/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(UpdateObjectAttributes))))
+	r.HandleFunc("/v1/file/versions/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(FileVersionsHandler))))
+	r.HandleFunc("/v1/file/trash/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(TrashHandler))))
+	r.HandleFunc("/v1/file/restore/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(RestoreHandler))))
 
 	r.HandleFunc("/v1/connection/commit/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(CommitHandler))))
+	r.HandleFunc("/v1/connection/rollback/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(RollbackHandler))))
 	r.HandleFunc("/v1/file/commitmetatxn/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(CommitMetaTxnHandler))))
 
+	//allocation migration
+	r.HandleFunc("/v1/allocation/migrate/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(MigrateAllocationHandler))))
+	r.HandleFunc("/v1/allocation/import/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(ImportAllocationHandler))))
+	r.HandleFunc("/v1/allocation/versioning/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(FileVersioningHandler))))
+
 	//object info related apis
 	r.HandleFunc("/allocation", common.UserRateLimit(common.ToJSONResponse(WithConnection(AllocationHandler))))
 	r.HandleFunc("/v1/file/meta/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(FileMetaHandler))))
 	r.HandleFunc("/v1/file/stats/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(FileStatsHandler))))
 	r.HandleFunc("/v1/file/list/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(ListHandler))))
+	r.HandleFunc("/v1/file/search/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(SearchHandler))))
 	r.HandleFunc("/v1/file/objectpath/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(ObjectPathHandler))))
 	r.HandleFunc("/v1/file/referencepath/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(ReferencePathHandler))))
 	r.HandleFunc("/v1/file/objecttree/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(ObjectTreeHandler))))
+	r.HandleFunc("/v1/file/events/{allocation}", common.UserRateLimit(common.ToByteStream(WithReadOnlyConnection(EventsHandler))))
 
 	//admin related
 	r.HandleFunc("/_debug", common.UserRateLimit(common.ToJSONResponse(DumpGoRoutines)))
 	r.HandleFunc("/_config", common.UserRateLimit(common.ToJSONResponse(GetConfig)))
 	r.HandleFunc("/_stats", common.UserRateLimit(stats.StatsHandler))
 	r.HandleFunc("/_statsJSON", common.UserRateLimit(common.ToJSONResponse(stats.StatsJSONHandler)))
+	r.Handle("/metrics", metrics.Handler())
 	r.HandleFunc("/_cleanupdisk", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(CleanupDiskHandler))))
+	r.HandleFunc("/_webhooks", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(WebhookDeliveriesHandler))))
+	r.HandleFunc("/_simulate_challenge/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(SimulateChallengeHandler))))
+	r.HandleFunc("/_validators", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(ValidatorStatsHandler))))
 	r.HandleFunc("/getstats", common.UserRateLimit(common.ToJSONResponse(stats.GetStatsHandler)))
 }
 
@@ -67,7 +93,7 @@ func WithReadOnlyConnection(handler common.JSONResponderF) common.JSONResponderF
 		ctx = GetMetaDataStore().CreateTransaction(ctx)
 		res, err := handler(ctx, r)
 		defer func() {
-			GetMetaDataStore().GetTransaction(ctx).Rollback()
+			GetMetaDataStore().Rollback(ctx)
 		}()
 		return res, err
 	}
@@ -79,14 +105,14 @@ func WithConnection(handler common.JSONResponderF) common.JSONResponderF {
 		res, err := handler(ctx, r)
 		defer func() {
 			if err != nil {
-				GetMetaDataStore().GetTransaction(ctx).Rollback()
+				GetMetaDataStore().Rollback(ctx)
 			}
 		}()
 		if err != nil {
 			Logger.Error("Error in handling the request." + err.Error())
 			return res, err
 		}
-		err = GetMetaDataStore().GetTransaction(ctx).Commit().Error
+		err = GetMetaDataStore().Commit(ctx)
 		if err != nil {
 			return res, common.NewError("commit_error", "Error committing to meta store")
 		}
@@ -145,6 +171,56 @@ func FileMetaHandler(ctx context.Context, r *http.Request) (interface{}, error)
 	return response, nil
 }
 
+// FileVersionsHandler lists the previous versions of a file.
+func FileVersionsHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.GetFileVersions(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// FileVersioningHandler sets the limits of the file versions of the
+// allocation.
+func FileVersioningHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.UpdateFileVersioning(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// TrashHandler lists the deleted files of the allocation that may be
+// restored.
+func TrashHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.ListTrash(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// RestoreHandler restores a deleted file of the trash.
+func RestoreHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.RestoreObject(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 func CommitMetaTxnHandler(ctx context.Context, r *http.Request) (interface{}, error) {
 	ctx = setupHandlerContext(ctx, r)
 
@@ -194,6 +270,31 @@ func DownloadHandler(ctx context.Context, r *http.Request) (interface{}, error)
 	return response, nil
 }
 
+/*StreamHandler is the handler to respond to streaming download requests from clients*/
+func StreamHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.StreamFile(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// EventsHandler streams the events of the changes committed to the
+// allocation.
+func EventsHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.WatchEvents(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 /*ListHandler is the handler to respond to upload requests fro clients*/
 func ListHandler(ctx context.Context, r *http.Request) (interface{}, error) {
 	ctx = setupHandlerContext(ctx, r)
@@ -210,6 +311,18 @@ func ListHandler(ctx context.Context, r *http.Request) (interface{}, error) {
 	return response, nil
 }
 
+// SearchHandler searches the files and directories under a directory.
+func SearchHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.SearchEntities(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 /*CommitHandler is the handler to respond to upload requests fro clients*/
 func CommitHandler(ctx context.Context, r *http.Request) (interface{}, error) {
 	ctx = setupHandlerContext(ctx, r)
@@ -226,6 +339,18 @@ func CommitHandler(ctx context.Context, r *http.Request) (interface{}, error) {
 	return response, nil
 }
 
+// RollbackHandler restores the allocation to a previous allocation root.
+func RollbackHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.Rollback(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 func ReferencePathHandler(ctx context.Context, r *http.Request) (interface{}, error) {
 	ctx = setupHandlerContext(ctx, r)
 
@@ -313,6 +438,26 @@ func CopyHandler(ctx context.Context, r *http.Request) (interface{}, error) {
 	return response, nil
 }
 
+func MoveHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+	response, err := storageHandler.MoveObject(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func CreateDirHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+	response, err := storageHandler.CreateDir(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 /*UploadHandler is the handler to respond to upload requests fro clients*/
 func UploadHandler(ctx context.Context, r *http.Request) (interface{}, error) {
 	ctx = setupHandlerContext(ctx, r)
@@ -348,6 +493,56 @@ func GetConfig(ctx context.Context, r *http.Request) (interface{}, error) {
 	return config.Configuration, nil
 }
 
+// WebhookDeliveriesHandler lists the log of the webhook deliveries, with
+// the status, event, before and limit filters.
+func WebhookDeliveriesHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	filter := webhook.DeliveryFilter{
+		Status: r.FormValue("status"),
+		Event:  r.FormValue("event"),
+	}
+	var err error
+	if before := r.FormValue("before"); before != "" {
+		if filter.Before, err = strconv.ParseInt(before, 10, 64); err != nil {
+			return nil, common.NewError("invalid_parameters", "Invalid before: "+err.Error())
+		}
+	}
+	if limit := r.FormValue("limit"); limit != "" {
+		if filter.Limit, err = strconv.Atoi(limit); err != nil {
+			return nil, common.NewError("invalid_parameters", "Invalid limit: "+err.Error())
+		}
+	}
+	log, err := webhook.GetDeliveries(ctx, filter)
+	if err != nil {
+		return nil, common.NewError("webhook_log_error", err.Error())
+	}
+	return log, nil
+}
+
+// SimulateChallengeHandler answers challenges of the allocation of the id
+// locally, from the seed for the rounds, and reports the files failing them.
+func SimulateChallengeHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	var (
+		seed   = time.Now().UnixNano()
+		rounds = 1
+		err    error
+	)
+	if s := r.FormValue("seed"); s != "" {
+		if seed, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return nil, common.NewError("invalid_parameters", "Invalid seed: "+err.Error())
+		}
+	}
+	if s := r.FormValue("rounds"); s != "" {
+		if rounds, err = strconv.Atoi(s); err != nil {
+			return nil, common.NewError("invalid_parameters", "Invalid rounds: "+err.Error())
+		}
+	}
+	return challenge.SimulateChallenges(ctx, mux.Vars(r)["allocation"], seed, rounds)
+}
+
+func ValidatorStatsHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	return challenge.GetValidatorStats(ctx)
+}
+
 func CleanupDiskHandler(ctx context.Context, r *http.Request) (interface{}, error) {
 	err := CleanupDiskFiles(ctx)
 	return "cleanup", err
@@ -42,6 +42,7 @@ func init() {
 		panic(err)
 	}
 	bconfig.Configuration.MaxFileSize = int64(1 << 30)
+	bconfig.Configuration.MaxBatchUploadFiles = 10
 }
 
 func setup(t *testing.T) {
@@ -170,6 +171,15 @@ func setupHandlers() (*mux.Router, map[string]string) {
 	),
 	).Name(uName)
 
+	buPath := "/v1/file/upload/batch/{allocation}"
+	buName := "Batch_Upload"
+	router.HandleFunc(buPath, common.UserRateLimit(
+		common.ToJSONResponse(
+			WithReadOnlyConnection(BatchUploadHandler),
+		),
+	),
+	).Name(buName)
+
 	return router,
 		map[string]string{
 			opPath:   opName,
@@ -181,12 +191,13 @@ func setupHandlers() (*mux.Router, map[string]string) {
 			cPath:    cName,
 			aPath:    aName,
 			uPath:    uName,
+			buPath:   buName,
 		}
 }
 
 func isEndpointAllowGetReq(name string) bool {
 	switch name {
-	case "Stats", "Rename", "Copy", "Attributes", "Upload":
+	case "Stats", "Rename", "Copy", "Attributes", "Upload", "Batch_Upload":
 		return false
 	default:
 		return true
@@ -984,6 +995,13 @@ func TestHandlers_Requiring_Signature(t *testing.T) {
 							AddRow(alloc.Terms[0].ID, alloc.Terms[0].AllocationID),
 					)
 
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(size), 0) FROM "allocation_connections"`)).
+					WithArgs(alloc.ID, allocation.NewConnection, allocation.InProgressConnection).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"size"}).
+							AddRow(0),
+					)
+
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "allocation_connections" WHERE`)).
 					WithArgs(connectionID, alloc.ID, alloc.OwnerID, allocation.DeletedConnection).
 					WillReturnRows(
@@ -1007,6 +1025,201 @@ func TestHandlers_Requiring_Signature(t *testing.T) {
 			},
 			wantCode: http.StatusOK,
 		},
+		{
+			name: "Batch_Upload_OK",
+			args: args{
+				w: httptest.NewRecorder(),
+				r: func() *http.Request {
+					handlerName := handlers["/v1/file/upload/batch/{allocation}"]
+					url, err := router.Get(handlerName).URL("allocation", alloc.Tx)
+					if err != nil {
+						t.Fatal()
+					}
+
+					metas := []*allocation.NewFileChange{
+						{Filename: "a.txt", Path: "/dir/a.txt"},
+						{Filename: "b.txt", Path: "/dir/b.txt"},
+					}
+					formFieldByt, err := json.Marshal(metas)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					body := bytes.NewBuffer(nil)
+					formWriter := multipart.NewWriter(body)
+					if err := formWriter.WriteField("connection_id", connectionID); err != nil {
+						t.Fatal(err)
+					}
+					if err := formWriter.WriteField("uploadMeta", string(formFieldByt)); err != nil {
+						t.Fatal(err)
+					}
+					for _, meta := range metas {
+						fileField, err := formWriter.CreateFormFile("uploadFile", meta.Filename)
+						if err != nil {
+							t.Fatal(err)
+						}
+						if _, err := fileField.Write([]byte("content of " + meta.Path)); err != nil {
+							t.Fatal(err)
+						}
+					}
+					if err := formWriter.Close(); err != nil {
+						t.Fatal(err)
+					}
+					r, err := http.NewRequest(http.MethodPost, url.String(), body)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					hash := encryption.Hash(alloc.Tx)
+					sign, err := sch.Sign(hash)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					r.Header.Set("Content-Type", formWriter.FormDataContentType())
+					r.Header.Set(common.ClientSignatureHeader, sign)
+					r.Header.Set(common.ClientHeader, alloc.OwnerID)
+
+					return r
+				}(),
+			},
+			alloc: alloc,
+			setupDbMock: func(mock sqlmock.Sqlmock) {
+				aa := sqlmock.AnyArg()
+
+				mock.ExpectBegin()
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "allocations" WHERE`)).
+					WithArgs(alloc.Tx).
+					WillReturnRows(
+						sqlmock.NewRows(
+							[]string{
+								"id", "tx", "expiration_date", "owner_public_key", "owner_id", "blobber_size",
+							},
+						).
+							AddRow(
+								alloc.ID, alloc.Tx, alloc.Expiration, alloc.OwnerPublicKey, alloc.OwnerID, int64(1<<30),
+							),
+					)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "terms" WHERE`)).
+					WithArgs(alloc.ID).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"id", "allocation_id"}).
+							AddRow(alloc.Terms[0].ID, alloc.Terms[0].AllocationID),
+					)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(size), 0) FROM "allocation_connections"`)).
+					WithArgs(alloc.ID, allocation.NewConnection, allocation.InProgressConnection).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"size"}).
+							AddRow(0),
+					)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "allocation_connections" WHERE`)).
+					WithArgs(connectionID, alloc.ID, alloc.OwnerID, allocation.DeletedConnection).
+					WillReturnRows(
+						sqlmock.NewRows([]string{}).
+							AddRow(),
+					)
+
+				for i := 0; i < 2; i++ {
+					mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "reference_objects"`)).
+						WithArgs(aa, aa).
+						WillReturnError(gorm.ErrRecordNotFound)
+				}
+
+				mock.ExpectExec(`INSERT INTO "allocation_connections"`).
+					WithArgs(aa, aa, aa, aa, aa, aa, aa).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "allocation_changes"`)).
+					WithArgs(aa, aa, aa, aa, aa, aa, aa, aa, aa, aa, aa, aa).
+					WillReturnRows(
+						sqlmock.NewRows([]string{}),
+					)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "Batch_Upload_Max_Allocation_Size",
+			args: args{
+				w: httptest.NewRecorder(),
+				r: func() *http.Request {
+					handlerName := handlers["/v1/file/upload/batch/{allocation}"]
+					url, err := router.Get(handlerName).URL("allocation", alloc.Tx)
+					if err != nil {
+						t.Fatal()
+					}
+
+					body := bytes.NewBuffer(nil)
+					formWriter := multipart.NewWriter(body)
+					if err := formWriter.WriteField("connection_id", connectionID); err != nil {
+						t.Fatal(err)
+					}
+					fileField, err := formWriter.CreateFormFile("uploadFile", "a.txt")
+					if err != nil {
+						t.Fatal(err)
+					}
+					// over the fields allowed of the 10 files of a batch
+					if _, err := fileField.Write(make([]byte, 11*UPLOAD_FORM_ALLOWANCE)); err != nil {
+						t.Fatal(err)
+					}
+					if err := formWriter.Close(); err != nil {
+						t.Fatal(err)
+					}
+					r, err := http.NewRequest(http.MethodPost, url.String(), body)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					hash := encryption.Hash(alloc.Tx)
+					sign, err := sch.Sign(hash)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					r.Header.Set("Content-Type", formWriter.FormDataContentType())
+					r.Header.Set(common.ClientSignatureHeader, sign)
+					r.Header.Set(common.ClientHeader, alloc.OwnerID)
+
+					return r
+				}(),
+			},
+			alloc: alloc,
+			setupDbMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "allocations" WHERE`)).
+					WithArgs(alloc.Tx).
+					WillReturnRows(
+						sqlmock.NewRows(
+							[]string{
+								"id", "tx", "expiration_date", "owner_public_key", "owner_id", "blobber_size",
+							},
+						).
+							AddRow(
+								alloc.ID, alloc.Tx, alloc.Expiration, alloc.OwnerPublicKey, alloc.OwnerID, int64(10),
+							),
+					)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "terms" WHERE`)).
+					WithArgs(alloc.ID).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"id", "allocation_id"}).
+							AddRow(alloc.Terms[0].ID, alloc.Terms[0].AllocationID),
+					)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(size), 0) FROM "allocation_connections"`)).
+					WithArgs(alloc.ID, allocation.NewConnection, allocation.InProgressConnection).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"size"}).
+							AddRow(0),
+					)
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: "{\"code\":\"max_allocation_size\",\"error\":\"max_allocation_size: Max size reached for the allocation with this blobber\"}\n\n",
+		},
 	}
 	tests := append(positiveTests, negativeTests...)
 	for _, test := range tests {
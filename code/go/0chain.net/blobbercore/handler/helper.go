@@ -2,11 +2,15 @@ package handler
 
 import (
 	"context"
+	"mime/multipart"
+	"net/http"
+	"net/url"
 
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/reference"
 	"0chain.net/blobbercore/stats"
 	"0chain.net/blobbercore/writemarker"
+	"0chain.net/core/common"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
@@ -22,6 +26,29 @@ func setupGRPCHandlerContext(ctx context.Context, r *blobbergrpc.RequestContext)
 	return ctx
 }
 
+// setupGRPCWriteContext sets up the context of the gRPC mutations, whose
+// allocation is given by the request rather than the request context.
+func setupGRPCWriteContext(ctx context.Context, r *blobbergrpc.RequestContext, allocationTx string) context.Context {
+	ctx = setupGRPCHandlerContext(ctx, r)
+	return context.WithValue(ctx, constants.ALLOCATION_CONTEXT_KEY, allocationTx)
+}
+
+// newGRPCRequest builds the http request the StorageHandler serves for a gRPC
+// call, so that both APIs share the same request handling. The form values
+// are set as if parsed from a multipart form.
+func newGRPCRequest(method string, r *blobbergrpc.RequestContext, allocationTx string, form url.Values) *http.Request {
+	req := &http.Request{
+		Method:        method,
+		URL:           &url.URL{},
+		Header:        make(http.Header),
+		Form:          form,
+		PostForm:      form,
+		MultipartForm: &multipart.Form{Value: form},
+	}
+	req.Header.Set(common.ClientSignatureHeader, r.ClientSignature)
+	return mux.SetURLVars(req, map[string]string{"allocation": allocationTx})
+}
+
 func RegisterGRPCServices(r *mux.Router, server *grpc.Server) {
 	packHandler := &packageHandler{}
 	blobberService := newGRPCBlobberService(&storageHandler, packHandler)
@@ -34,6 +61,16 @@ func RegisterGRPCServices(r *mux.Router, server *grpc.Server) {
 type StorageHandlerI interface {
 	verifyAllocation(ctx context.Context, tx string, readonly bool) (alloc *allocation.Allocation, err error)
 	verifyAuthTicket(ctx context.Context, authTokenString string, allocationObj *allocation.Allocation, refRequested *reference.Ref, clientID string) (bool, error)
+
+	WriteFile(ctx context.Context, r *http.Request) (*UploadResult, error)
+	StreamFile(ctx context.Context, r *http.Request) (interface{}, error)
+	RenameObject(ctx context.Context, r *http.Request) (interface{}, error)
+	CopyObject(ctx context.Context, r *http.Request) (interface{}, error)
+	UpdateObjectAttributes(ctx context.Context, r *http.Request) (interface{}, error)
+	CommitWrite(ctx context.Context, r *http.Request) (*CommitResult, error)
+	AddCollaborator(ctx context.Context, r *http.Request) (interface{}, error)
+	CalculateHash(ctx context.Context, r *http.Request) (interface{}, error)
+	WatchEvents(ctx context.Context, r *http.Request) (*EventStream, error)
 }
 
 // PackageHandler is an interface for all static functions that may need to be mocked
@@ -45,25 +82,35 @@ type PackageHandler interface {
 	GetFileStats(ctx context.Context, refID int64) (*stats.FileStats, error)
 	GetWriteMarkerEntity(ctx context.Context, allocation_root string) (*writemarker.WriteMarkerEntity, error)
 	GetRefWithChildren(ctx context.Context, allocationID string, path string) (*reference.Ref, error)
+	GetRefWithChildrenPage(ctx context.Context, allocationID string, path string, page *reference.ListPage) (*reference.Ref, string, error)
 	GetObjectPathGRPC(ctx context.Context, allocationID string, blockNum int64) (*blobbergrpc.ObjectPath, error)
-	GetReferencePathFromPaths(ctx context.Context, allocationID string, paths []string) (*reference.Ref, error)
-	GetObjectTree(ctx context.Context, allocationID string, path string) (*reference.Ref, error)
+	GetReferencePathFromPaths(ctx context.Context, allocationID string, paths []string, depth int) (*reference.Ref, error)
+	GetObjectTree(ctx context.Context, allocationID string, path string, depth int) (*reference.Ref, error)
+	SearchRefs(ctx context.Context, allocationID string, filter *reference.SearchFilter) ([]*reference.Ref, int64, error)
 }
 
 type packageHandler struct{}
 
-func (r *packageHandler) GetObjectTree(ctx context.Context, allocationID string, path string) (*reference.Ref, error) {
-	return reference.GetObjectTree(ctx, allocationID, path)
+func (r *packageHandler) GetObjectTree(ctx context.Context, allocationID string, path string, depth int) (*reference.Ref, error) {
+	return reference.GetObjectTreeDepth(ctx, allocationID, path, depth)
 }
 
-func (r *packageHandler) GetReferencePathFromPaths(ctx context.Context, allocationID string, paths []string) (*reference.Ref, error) {
-	return reference.GetReferencePathFromPaths(ctx, allocationID, paths)
+func (r *packageHandler) SearchRefs(ctx context.Context, allocationID string, filter *reference.SearchFilter) ([]*reference.Ref, int64, error) {
+	return reference.SearchRefs(ctx, allocationID, filter)
+}
+
+func (r *packageHandler) GetReferencePathFromPaths(ctx context.Context, allocationID string, paths []string, depth int) (*reference.Ref, error) {
+	return reference.GetReferencePathFromPathsDepth(ctx, allocationID, paths, depth)
 }
 
 func (r *packageHandler) GetRefWithChildren(ctx context.Context, allocationID string, path string) (*reference.Ref, error) {
 	return reference.GetRefWithChildren(ctx, allocationID, path)
 }
 
+func (r *packageHandler) GetRefWithChildrenPage(ctx context.Context, allocationID string, path string, page *reference.ListPage) (*reference.Ref, string, error) {
+	return reference.GetRefWithChildrenPage(ctx, allocationID, path, page)
+}
+
 func (r *packageHandler) GetObjectPathGRPC(ctx context.Context, allocationID string, blockNum int64) (*blobbergrpc.ObjectPath, error) {
 	return reference.GetObjectPathGRPC(ctx, allocationID, blockNum)
 }
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/constants"
+	"0chain.net/blobbercore/migration"
+	"0chain.net/core/common"
+	"0chain.net/core/lock"
+	"0chain.net/core/node"
+)
+
+/*MigrateAllocationHandler is the handler to move an allocation to a peer blobber*/
+func MigrateAllocationHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.MigrateAllocation(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+/*ImportAllocationHandler is the handler to receive an allocation from a peer blobber*/
+func ImportAllocationHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.ImportAllocation(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// MigrateAllocation streams the allocation to the blobber_id peer, which has
+// to be one of the blobbers of the allocation on the chain, and cleans it up
+// here once the peer reports the same allocation root.
+func (fsh *StorageHandler) MigrateAllocation(ctx context.Context, r *http.Request) (*migration.ImportResult, error) {
+	if r.Method != http.MethodPost {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use POST instead")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, true)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+	if err = verifyOwnerRequest(r, clientID, allocationObj.OwnerID, allocationObj.OwnerPublicKey); err != nil {
+		return nil, err
+	}
+
+	blobberID := r.FormValue("blobber_id")
+	if len(blobberID) == 0 || blobberID == node.Self.ID {
+		return nil, common.NewError("invalid_parameters", "Invalid blobber id passed")
+	}
+	sa, err := requestStorageAllocation(allocationObj.ID)
+	if err != nil {
+		return nil, common.NewError("request_allocation_error", err.Error())
+	}
+	var blobberURL string
+	for _, b := range sa.Blobbers {
+		if b.ID == blobberID {
+			blobberURL = b.BaseURL
+			break
+		}
+	}
+	if len(blobberURL) == 0 {
+		return nil, common.NewError("invalid_blobber", "Blobber is not part of the allocation")
+	}
+
+	mutex := lock.GetMutex(allocationObj.TableName(), allocationObj.ID)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	header := make(http.Header)
+	for _, k := range []string{common.ClientHeader, common.ClientKeyHeader, common.ClientSignatureHeader} {
+		header.Set(k, r.Header.Get(k))
+	}
+	return migration.Migrate(ctx, allocationObj, blobberURL, header)
+}
+
+// ImportAllocation stores the allocation streamed by a peer blobber on behalf
+// of the owner of the allocation.
+func (fsh *StorageHandler) ImportAllocation(ctx context.Context, r *http.Request) (*migration.ImportResult, error) {
+	if r.Method != http.MethodPost {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use POST instead")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+
+	allocationID := r.URL.Query().Get("allocation_id")
+	if len(allocationID) == 0 {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed")
+	}
+	sa, err := requestStorageAllocation(allocationID)
+	if err != nil {
+		return nil, common.NewError("request_allocation_error", err.Error())
+	}
+	if sa.ID != allocationID || sa.Tx != allocationTx {
+		return nil, common.NewError("invalid_parameters", "Allocation transaction does not match the chain")
+	}
+	if err = verifyOwnerRequest(r, clientID, sa.OwnerID, sa.OwnerPublicKey); err != nil {
+		return nil, err
+	}
+
+	mutex := lock.GetMutex((&allocation.Allocation{}).TableName(), sa.ID)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return migration.Import(ctx, sa, r.Body)
+}
+
+// requestStorageAllocation fetches the allocation from the chain, replaced in
+// tests
+var requestStorageAllocation = allocation.RequestAllocation
+
+func verifyOwnerRequest(r *http.Request, clientID, ownerID, ownerPublicKey string) error {
+	if len(clientID) == 0 || clientID != ownerID {
+		return common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+	valid, err := verifySignatureFromRequest(r, ownerPublicKey)
+	if !valid || err != nil {
+		return common.NewError("invalid_signature", "Invalid signature")
+	}
+	return nil
+}
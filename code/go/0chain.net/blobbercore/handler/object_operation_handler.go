@@ -5,19 +5,24 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 
 	"net/http"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/config"
 	"0chain.net/blobbercore/constants"
 	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/feed"
 	"0chain.net/blobbercore/filestore"
 	"0chain.net/blobbercore/readmarker"
 	"0chain.net/blobbercore/reference"
 	"0chain.net/blobbercore/stats"
+	"0chain.net/blobbercore/webhook"
 	"0chain.net/blobbercore/writemarker"
 
 	"0chain.net/core/common"
@@ -171,6 +176,94 @@ func writePreRedeem(ctx context.Context, alloc *allocation.Allocation,
 	return
 }
 
+// authorizeRead checks that the client may read the file, either as the
+// owner, payer or a collaborator of the allocation or by an auth ticket. It
+// returns the id of the client paying for the reads and attaches the auth
+// ticket to the read marker.
+func (fsh *StorageHandler) authorizeRead(ctx context.Context, r *http.Request,
+	allocationObj *allocation.Allocation, fileref *reference.Ref,
+	clientID string, readMarker *readmarker.ReadMarker) (
+	clientIDForReadRedeem string, err error) {
+
+	var (
+		authTokenString = r.FormValue("auth_token")
+		rxPay           = r.FormValue("rx_pay") == "true"
+		isACollaborator = reference.IsACollaborator(ctx, fileref.ID, clientID)
+	)
+
+	clientIDForReadRedeem = clientID // default payer is client
+
+	// Owner will pay for collaborator
+	if isACollaborator {
+		clientIDForReadRedeem = allocationObj.OwnerID
+	}
+
+	if (allocationObj.OwnerID != clientID &&
+		allocationObj.PayerID != clientID &&
+		!isACollaborator) || len(authTokenString) > 0 {
+
+		var authTicketVerified bool
+		authTicketVerified, err = fsh.verifyAuthTicket(ctx, authTokenString, allocationObj,
+			fileref, clientID)
+		if err != nil {
+			return "", common.NewErrorf("download_file",
+				"verifying auth ticket: %v", err)
+		}
+
+		if !authTicketVerified {
+			return "", common.NewErrorf("download_file",
+				"could not verify the auth ticket")
+		}
+
+		var authToken = &readmarker.AuthTicket{}
+		err = json.Unmarshal([]byte(authTokenString), &authToken)
+		if err != nil {
+			return "", common.NewErrorf("download_file",
+				"error parsing the auth ticket for download: %v", err)
+		}
+
+		var attrs *reference.Attributes
+		if attrs, err = fileref.GetAttributes(); err != nil {
+			return "", common.NewErrorf("download_file",
+				"error getting file attributes: %v", err)
+		}
+
+		// if --rx_pay used 3rd_party pays
+		if rxPay {
+			clientIDForReadRedeem = clientID
+		} else if attrs.WhoPaysForReads == common.WhoPaysOwner {
+			clientIDForReadRedeem = allocationObj.OwnerID // owner pays
+		}
+
+		readMarker.AuthTicket = datatypes.JSON(authTokenString)
+	}
+
+	return clientIDForReadRedeem, nil
+}
+
+// latestReadMarker returns the latest read marker of the client, if any, and
+// the number of its blocks pending redeeming.
+func latestReadMarker(ctx context.Context, clientID string) (
+	latestRM *readmarker.ReadMarker, pendNumBlocks int64, err error) {
+
+	var rme *readmarker.ReadMarkerEntity
+	rme, err = readmarker.GetLatestReadMarkerEntity(ctx, clientID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, 0, common.NewErrorf("download_file",
+			"couldn't get read marker from DB: %v", err)
+	}
+
+	if rme != nil {
+		latestRM = rme.LatestRM
+		if pendNumBlocks, err = rme.PendNumBlocks(); err != nil {
+			return nil, 0, common.NewErrorf("download_file",
+				"couldn't get number of blocks pending redeeming: %v", err)
+		}
+	}
+
+	return latestRM, pendNumBlocks, nil
+}
+
 func (fsh *StorageHandler) DownloadFile(ctx context.Context, r *http.Request) (
 	resp interface{}, err error) {
 
@@ -208,8 +301,6 @@ func (fsh *StorageHandler) DownloadFile(ctx context.Context, r *http.Request) (
 			"request_parse_error: %v", err)
 	}
 
-	rxPay := r.FormValue("rx_pay") == "true"
-
 	pathHash, err := pathHashFromReq(r, allocationID)
 	if err != nil {
 		return nil, common.NewError("download_file", "invalid path")
@@ -269,74 +360,22 @@ func (fsh *StorageHandler) DownloadFile(ctx context.Context, r *http.Request) (
 			"path is not a file: %v", err)
 	}
 
-	var (
-		authTokenString       = r.FormValue("auth_token")
-		clientIDForReadRedeem = clientID // default payer is client
-		isACollaborator       = reference.IsACollaborator(ctx, fileref.ID, clientID)
-	)
-
-	// Owner will pay for collaborator
-	if isACollaborator {
-		clientIDForReadRedeem = allocationObj.OwnerID
-	}
-
-	if (allocationObj.OwnerID != clientID &&
-		allocationObj.PayerID != clientID &&
-		!isACollaborator) || len(authTokenString) > 0 {
-
-		var authTicketVerified bool
-		authTicketVerified, err = fsh.verifyAuthTicket(ctx, r.FormValue("auth_token"), allocationObj,
-			fileref, clientID)
-		if err != nil {
-			return nil, common.NewErrorf("download_file",
-				"verifying auth ticket: %v", err)
-		}
-
-		if !authTicketVerified {
-			return nil, common.NewErrorf("download_file",
-				"could not verify the auth ticket")
-		}
-
-		var authToken = &readmarker.AuthTicket{}
-		err = json.Unmarshal([]byte(authTokenString), &authToken)
-		if err != nil {
-			return nil, common.NewErrorf("download_file",
-				"error parsing the auth ticket for download: %v", err)
-		}
-
-		var attrs *reference.Attributes
-		if attrs, err = fileref.GetAttributes(); err != nil {
-			return nil, common.NewErrorf("download_file",
-				"error getting file attributes: %v", err)
-		}
-
-		// if --rx_pay used 3rd_party pays
-		if rxPay {
-			clientIDForReadRedeem = clientID
-		} else if attrs.WhoPaysForReads == common.WhoPaysOwner {
-			clientIDForReadRedeem = allocationObj.OwnerID // owner pays
-		}
-
-		readMarker.AuthTicket = datatypes.JSON(authTokenString)
+	var clientIDForReadRedeem string
+	clientIDForReadRedeem, err = fsh.authorizeRead(ctx, r, allocationObj,
+		fileref, clientID, readMarker)
+	if err != nil {
+		return nil, err
 	}
 
-	var (
-		rme           *readmarker.ReadMarkerEntity
-		latestRM      *readmarker.ReadMarker
-		pendNumBlocks int64
-	)
-	rme, err = readmarker.GetLatestReadMarkerEntity(ctx, clientID)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, common.NewErrorf("download_file",
-			"couldn't get read marker from DB: %v", err)
+	if err = fileVersionFromReq(ctx, r, fileref); err != nil {
+		return nil, common.NewErrorf("download_file", "invalid version: %v", err)
 	}
 
-	if rme != nil {
-		latestRM = rme.LatestRM
-		if pendNumBlocks, err = rme.PendNumBlocks(); err != nil {
-			return nil, common.NewErrorf("download_file",
-				"couldn't get number of blocks pending redeeming: %v", err)
-		}
+	var latestRM *readmarker.ReadMarker
+	var pendNumBlocks int64
+	latestRM, pendNumBlocks, err = latestReadMarker(ctx, clientID)
+	if err != nil {
+		return nil, err
 	}
 
 	if latestRM != nil &&
@@ -409,6 +448,165 @@ func (fsh *StorageHandler) DownloadFile(ctx context.Context, r *http.Request) (
 	return respData, nil
 }
 
+// StreamFile serves the file content to a GET request straight from the file
+// store. A single Range header is honoured and If-None-Match is checked against
+// the content hash. The read marker has to cover the 64 KiB blocks overlapped
+// by the served range.
+func (fsh *StorageHandler) StreamFile(ctx context.Context, r *http.Request) (
+	resp interface{}, err error) {
+
+	if r.Method != http.MethodGet {
+		return nil, common.NewErrorf("stream_file",
+			"invalid method used (%s), use GET instead", r.Method)
+	}
+
+	var (
+		allocationTx = ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+		clientID     = ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+
+		allocationObj *allocation.Allocation
+	)
+
+	if len(clientID) == 0 {
+		return nil, common.NewError("stream_file", "invalid client")
+	}
+
+	allocationObj, err = fsh.verifyAllocation(ctx, allocationTx, false)
+	if err != nil {
+		return nil, common.NewErrorf("stream_file",
+			"invalid allocation id passed: %v", err)
+	}
+
+	var allocationID = allocationObj.ID
+
+	pathHash, err := pathHashFromReq(r, allocationID)
+	if err != nil {
+		return nil, common.NewError("stream_file", "invalid path")
+	}
+
+	var readMarker = &readmarker.ReadMarker{}
+	err = json.Unmarshal([]byte(r.FormValue("read_marker")), &readMarker)
+	if err != nil {
+		return nil, common.NewErrorf("stream_file", "invalid parameters, "+
+			"error parsing the readmarker for download: %v", err)
+	}
+
+	var rmObj = &readmarker.ReadMarkerEntity{}
+	rmObj.LatestRM = readMarker
+
+	if err = rmObj.VerifyMarker(ctx, allocationObj); err != nil {
+		return nil, common.NewErrorf("stream_file", "invalid read marker, "+
+			"failed to verify the read marker: %v", err)
+	}
+
+	var fileref *reference.Ref
+	fileref, err = reference.GetReferenceFromLookupHash(ctx, allocationID,
+		pathHash)
+	if err != nil {
+		return nil, common.NewErrorf("stream_file",
+			"invalid file path: %v", err)
+	}
+
+	if fileref.Type != reference.FILE {
+		return nil, common.NewError("stream_file", "path is not a file")
+	}
+
+	var clientIDForReadRedeem string
+	clientIDForReadRedeem, err = fsh.authorizeRead(ctx, r, allocationObj,
+		fileref, clientID, readMarker)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = fileVersionFromReq(ctx, r, fileref); err != nil {
+		return nil, common.NewErrorf("stream_file", "invalid version: %v", err)
+	}
+
+	var fileData = &filestore.FileInputData{}
+	fileData.Name = fileref.Name
+	fileData.Path = fileref.Path
+	fileData.Hash = fileref.ContentHash
+	fileData.OnCloud = fileref.OnCloud
+	if r.FormValue("content") == DOWNLOAD_CONTENT_THUMB {
+		fileData.Hash = fileref.ThumbnailHash
+	}
+
+	var streamResp = newStreamResponse(`"` + fileData.Hash + `"`)
+	if etagMatches(r.Header.Get("If-None-Match"), streamResp.Header.Get("ETag")) {
+		streamResp.Status = http.StatusNotModified
+		return streamResp, nil
+	}
+
+	reader, size, err := filestore.GetFileStore().GetFileReader(allocationID,
+		fileData)
+	if err != nil {
+		return nil, common.NewErrorf("stream_file",
+			"couldn't open file: %v", err)
+	}
+	defer func() {
+		if streamResp.reader == nil {
+			reader.Close()
+		}
+	}()
+
+	var rangeHeader = r.Header.Get("Range")
+	start, length, err := parseRange(rangeHeader, size)
+	if err != nil {
+		streamResp.Status = http.StatusRequestedRangeNotSatisfiable
+		streamResp.Header.Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+		return streamResp, nil
+	}
+
+	var numBlocks = blocksInRange(start, length)
+
+	var latestRM *readmarker.ReadMarker
+	var pendNumBlocks int64
+	latestRM, pendNumBlocks, err = latestReadMarker(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if latestRM != nil &&
+		latestRM.ReadCounter+numBlocks != readMarker.ReadCounter {
+
+		var response = &DownloadResponse{
+			Success:      false,
+			LatestRM:     latestRM,
+			Path:         fileref.Path,
+			AllocationID: fileref.AllocationID,
+		}
+		return response, nil
+	}
+
+	// check out read pool tokens if read_price > 0
+	err = readPreRedeem(ctx, allocationObj, numBlocks, pendNumBlocks,
+		clientIDForReadRedeem)
+	if err != nil {
+		return nil, common.NewErrorf("stream_file",
+			"pre-redeeming read marker: %v", err)
+	}
+
+	readMarker.PayerID = clientIDForReadRedeem
+	err = readmarker.SaveLatestReadMarker(ctx, readMarker, latestRM == nil)
+	if err != nil {
+		return nil, common.NewErrorf("stream_file",
+			"couldn't save latest read marker: %v", err)
+	}
+
+	stats.FileBlockDownloaded(ctx, fileref.ID)
+
+	streamResp.reader = reader
+	streamResp.body = io.NewSectionReader(reader, start, length)
+	streamResp.Header.Set("Content-Type", "application/octet-stream")
+	streamResp.Header.Set("Content-Length", strconv.FormatInt(length, 10))
+	if len(rangeHeader) > 0 {
+		streamResp.Status = http.StatusPartialContent
+		streamResp.Header.Set("Content-Range",
+			fmt.Sprintf("bytes %d-%d/%d", start, start+length-1, size))
+	}
+	return streamResp, nil
+}
+
 func (fsh *StorageHandler) CommitWrite(ctx context.Context, r *http.Request) (*CommitResult, error) {
 
 	if r.Method == "GET" {
@@ -561,7 +759,31 @@ func (fsh *StorageHandler) CommitWrite(ctx context.Context, r *http.Request) (*C
 	if err != nil {
 		return nil, common.NewError("allocation_write_error", "Error persisting the allocation object")
 	}
-	err = connectionObj.CommitToFileStore(ctx)
+	if config.Configuration.ReferenceSnapshots > 0 {
+		err = reference.SaveSnapshot(ctx, allocationID, allocationRoot, config.Configuration.ReferenceSnapshots)
+		if err != nil {
+			return nil, common.NewError("snapshot_error", "Error saving the reference snapshot. "+err.Error())
+		}
+	}
+	events, err := connectionObj.Events(ctx, allocationRoot)
+	if err == nil {
+		err = feed.AddEvents(ctx, allocationID, events)
+	}
+	if err != nil {
+		return nil, common.NewError("event_error", "Error adding the events of the commit. "+err.Error())
+	}
+	// not to fail the commit, notifying is logged only
+	err = webhook.Notify(ctx, webhook.EventCommit, &webhook.CommitData{
+		AllocationID:       allocationID,
+		AllocationRoot:     allocationRoot,
+		PrevAllocationRoot: writeMarker.PreviousAllocationRoot,
+		ClientID:           clientID,
+		Size:               writeMarker.Size,
+	})
+	if err != nil {
+		Logger.Error("Notifying the commit", zap.String("allocation_id", allocationID), zap.Error(err))
+	}
+	err = connectionObj.CommitToFileStore(ctx, allocationRoot)
 	if err != nil {
 		return nil, common.NewError("file_store_error", "Error committing to file store. "+err.Error())
 	}
@@ -580,6 +802,85 @@ func (fsh *StorageHandler) CommitWrite(ctx context.Context, r *http.Request) (*C
 	return &result, nil
 }
 
+// Rollback restores the allocation to a previous allocation root, of which a
+// reference snapshot is kept. The owner signs the hash of the allocation id,
+// the current and the previous allocation roots.
+func (fsh *StorageHandler) Rollback(ctx context.Context, r *http.Request) (*CommitResult, error) {
+
+	if r.Method == "GET" {
+		return nil, common.NewError("invalid_method", "Invalid method used for the rollback URL. Use POST instead")
+	}
+	if config.Configuration.ReferenceSnapshots <= 0 {
+		return nil, common.NewError("invalid_operation", "Rollback is disabled on the blobber")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, false)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+	allocationID := allocationObj.ID
+
+	if len(clientID) == 0 || allocationObj.OwnerID != clientID {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+
+	allocationRoot := r.FormValue("allocation_root")
+	if len(allocationRoot) == 0 {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation root passed")
+	}
+
+	mutex := lock.GetMutex(allocationObj.TableName(), allocationID)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if allocationRoot == allocationObj.AllocationRoot {
+		return nil, common.NewError("invalid_parameters", "The allocation is already at the allocation root")
+	}
+
+	hash := encryption.Hash(allocationID + ":" + allocationObj.AllocationRoot + ":" + allocationRoot)
+	valid, err := encryption.Verify(encryption.MiraclToHerumiPK(allocationObj.OwnerPublicKey),
+		encryption.MiraclToHerumiSig(r.FormValue("signature")), hash)
+	if !valid || err != nil {
+		return nil, common.NewError("invalid_signature", "Invalid signature of the rollback")
+	}
+
+	rollbackWM, err := writemarker.GetWriteMarkerEntity(ctx, allocationRoot)
+	if err != nil || rollbackWM.WM.AllocationID != allocationID {
+		return nil, common.NewError("invalid_parameters", "No write marker of the allocation with the allocation root")
+	}
+
+	if err = allocation.Rollback(ctx, allocationObj, allocationRoot); err != nil {
+		return nil, err
+	}
+
+	rootRef, err := reference.GetReference(ctx, allocationID, "/")
+	if err != nil {
+		return nil, err
+	}
+	restoredRoot := encryption.Hash(rootRef.Hash + ":" + strconv.FormatInt(int64(rollbackWM.WM.Timestamp), 10))
+	if restoredRoot != allocationRoot {
+		return nil, common.NewError("allocation_root_mismatch",
+			"The restored references do not match the allocation root. Calculated hash: "+restoredRoot)
+	}
+	err = feed.AddEvents(ctx, allocationID, []*feed.Event{{
+		AllocationRoot: allocationRoot,
+		Operation:      allocation.ROLLBACK_OPERATION,
+		Path:           "/",
+		Hash:           rootRef.Hash,
+	}})
+	if err != nil {
+		return nil, common.NewError("event_error", "Error adding the event of the rollback. "+err.Error())
+	}
+
+	var result CommitResult
+	result.AllocationRoot = allocationRoot
+	result.WriteMarker = &rollbackWM.WM
+	result.Success = true
+	return &result, nil
+}
+
 func (fsh *StorageHandler) RenameObject(ctx context.Context, r *http.Request) (interface{}, error) {
 
 	if r.Method == "GET" {
@@ -862,6 +1163,159 @@ func (fsh *StorageHandler) CopyObject(ctx context.Context, r *http.Request) (int
 	return result, nil
 }
 
+// CreateDir stages the creation of a directory, with its missing parents, in
+// the connection.
+func (fsh *StorageHandler) CreateDir(ctx context.Context, r *http.Request) (*UploadResult, error) {
+	if r.Method != http.MethodPost {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use POST instead")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, false)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+	allocationID := allocationObj.ID
+
+	valid, err := verifySignatureFromRequest(r, allocationObj.OwnerPublicKey)
+	if !valid || err != nil {
+		return nil, common.NewError("invalid_signature", "Invalid signature")
+	}
+
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	if len(clientID) == 0 || allocationObj.OwnerID != clientID {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+
+	dirPath := r.FormValue("path")
+	if len(dirPath) == 0 || !filepath.IsAbs(dirPath) || filepath.Clean(dirPath) == "/" {
+		return nil, common.NewError("invalid_parameters", "Invalid path")
+	}
+	dirPath = filepath.Clean(dirPath)
+
+	connectionID := r.FormValue("connection_id")
+	if len(connectionID) == 0 {
+		return nil, common.NewError("invalid_parameters", "Invalid connection id passed")
+	}
+
+	connectionObj, err := allocation.GetAllocationChanges(ctx, connectionID, allocationID, clientID)
+	if err != nil {
+		return nil, common.NewError("meta_error", "Error reading metadata for connection")
+	}
+
+	mutex := lock.GetMutex(connectionObj.TableName(), connectionID)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if existingRef, _ := reference.GetReference(ctx, allocationID, dirPath); existingRef != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid path. Object Already exists.")
+	}
+
+	allocationChange := &allocation.AllocationChange{}
+	allocationChange.ConnectionID = connectionObj.ConnectionID
+	allocationChange.Size = 0
+	allocationChange.Operation = allocation.CREATEDIR_OPERATION
+	cdc := &allocation.CreateDirChange{ConnectionID: connectionObj.ConnectionID,
+		AllocationID: connectionObj.AllocationID, Path: dirPath}
+	connectionObj.AddChange(allocationChange, cdc)
+
+	err = connectionObj.Save(ctx)
+	if err != nil {
+		Logger.Error("Error in writing the connection meta data", zap.Error(err))
+		return nil, common.NewError("connection_write_error", "Error writing the connection meta data")
+	}
+
+	result := &UploadResult{}
+	result.Filename = filepath.Base(dirPath)
+	return result, nil
+}
+
+// MoveObject stages the move of a file, or of a directory with all its
+// content, to the dest directory in the connection.
+func (fsh *StorageHandler) MoveObject(ctx context.Context, r *http.Request) (*UploadResult, error) {
+	if r.Method != http.MethodPost {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use POST instead")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, false)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+	allocationID := allocationObj.ID
+
+	valid, err := verifySignatureFromRequest(r, allocationObj.OwnerPublicKey)
+	if !valid || err != nil {
+		return nil, common.NewError("invalid_signature", "Invalid signature")
+	}
+
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	if len(clientID) == 0 || allocationObj.OwnerID != clientID {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+
+	destPath := r.FormValue("dest")
+	if len(destPath) == 0 || !filepath.IsAbs(destPath) {
+		return nil, common.NewError("invalid_parameters", "Invalid destination for operation")
+	}
+	destPath = filepath.Clean(destPath)
+
+	pathHash, err := pathHashFromReq(r, allocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionID := r.FormValue("connection_id")
+	if len(connectionID) == 0 {
+		return nil, common.NewError("invalid_parameters", "Invalid connection id passed")
+	}
+
+	connectionObj, err := allocation.GetAllocationChanges(ctx, connectionID, allocationID, clientID)
+	if err != nil {
+		return nil, common.NewError("meta_error", "Error reading metadata for connection")
+	}
+
+	mutex := lock.GetMutex(connectionObj.TableName(), connectionID)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	objectRef, err := reference.GetReferenceFromLookupHash(ctx, allocationID, pathHash)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid file path. "+err.Error())
+	}
+	if objectRef.Path == "/" || destPath == objectRef.Path ||
+		strings.HasPrefix(destPath, objectRef.Path+"/") {
+		return nil, common.NewError("invalid_parameters", "Invalid destination path. Cannot move a directory into itself.")
+	}
+	newPath := filepath.Join(destPath, objectRef.Name)
+	if existingRef, _ := reference.GetReference(ctx, allocationID, newPath); existingRef != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid destination path. Object Already exists.")
+	}
+	if destRef, _ := reference.GetReference(ctx, allocationID, destPath); destRef != nil && destRef.Type != reference.DIRECTORY {
+		return nil, common.NewError("invalid_parameters", "Invalid destination path. Should be a directory.")
+	}
+
+	allocationChange := &allocation.AllocationChange{}
+	allocationChange.ConnectionID = connectionObj.ConnectionID
+	allocationChange.Size = 0
+	allocationChange.Operation = allocation.MOVE_OPERATION
+	mfc := &allocation.MoveFileChange{ConnectionID: connectionObj.ConnectionID,
+		AllocationID: connectionObj.AllocationID, SrcPath: objectRef.Path, DestPath: destPath}
+	connectionObj.AddChange(allocationChange, mfc)
+
+	err = connectionObj.Save(ctx)
+	if err != nil {
+		Logger.Error("Error in writing the connection meta data", zap.Error(err))
+		return nil, common.NewError("connection_write_error", "Error writing the connection meta data")
+	}
+
+	result := &UploadResult{}
+	result.Filename = objectRef.Name
+	result.Hash = objectRef.Hash
+	result.MerkleRoot = objectRef.MerkleRoot
+	result.Size = objectRef.Size
+
+	return result, nil
+}
+
 func (fsh *StorageHandler) DeleteFile(ctx context.Context, r *http.Request, connectionObj *allocation.AllocationChangeCollector) (*UploadResult, error) {
 	path := r.FormValue("path")
 	if len(path) == 0 {
@@ -922,7 +1376,28 @@ func (fsh *StorageHandler) WriteFile(ctx context.Context, r *http.Request) (*Upl
 		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner or the payer of the allocation")
 	}
 
+	quota, err := allocation.NewQuotaReservation(ctx, allocationObj)
+	if err != nil {
+		return nil, err
+	}
+	quota.ReleaseWithTransaction(ctx)
+
+	// fail before receiving a new file which can't fit. The form is spooled
+	// before its file streams through the quota reader, a new file is so
+	// refused as it is received, the thumbnail and the fields allowed over.
+	// An update frees the size of the file it replaces, known from the form.
+	if r.Method == "POST" {
+		allowance := UPLOAD_FORM_ALLOWANCE + config.Configuration.MaxThumbnailSize
+		if quota.Available() <= 0 || r.ContentLength > quota.Available()+allowance {
+			return nil, allocation.MaxAllocationSizeReached
+		}
+		r.Body = quota.LimitBody(r.Body, allowance)
+	}
+
 	if err := r.ParseMultipartForm(FORM_FILE_PARSE_MAX_MEMORY); err != nil {
+		if quota.Exceeded() {
+			return nil, allocation.MaxAllocationSizeReached
+		}
 		Logger.Info("Error Parsing the request", zap.Any("error", err))
 		return nil, common.NewError("request_parse_error", err.Error())
 	}
@@ -997,6 +1472,11 @@ func (fsh *StorageHandler) WriteFile(ctx context.Context, r *http.Request) (*Upl
 			exisitingFileOnCloud = exisitingFileRef.OnCloud
 		}
 
+		quota.SetCredit(existingFileRefSize)
+		if !formData.IsResumable && formData.Size > quota.Available() {
+			return nil, allocation.MaxAllocationSizeReached
+		}
+
 		origfile, _, err := r.FormFile("uploadFile")
 		if err != nil {
 			return nil, common.NewError("invalid_parameters", "Error Reading multi parts for file."+err.Error())
@@ -1008,11 +1488,19 @@ func (fsh *StorageHandler) WriteFile(ctx context.Context, r *http.Request) (*Upl
 		if thumbHeader != nil {
 			thumbnailPresent = true
 			defer thumbfile.Close()
+			if thumbHeader.Size > config.Configuration.MaxThumbnailSize {
+				return nil, common.NewError("thumbnail_size_limit_exceeded",
+					"Size for the given thumbnail is larger than the max limit")
+			}
 		}
 
 		fileInputData := &filestore.FileInputData{Name: formData.Filename, Path: formData.Path, OnCloud: exisitingFileOnCloud}
-		fileOutputData, err := filestore.GetFileStore().WriteFile(allocationID, fileInputData, origfile, connectionObj.ConnectionID)
+		fileOutputData, err := filestore.GetFileStore().WriteFile(allocationID, fileInputData, quota.Reader(origfile), connectionObj.ConnectionID)
 		if err != nil {
+			if quota.Exceeded() {
+				_ = filestore.GetFileStore().DeleteTempFile(allocationID, fileInputData, connectionObj.ConnectionID)
+				return nil, allocation.MaxAllocationSizeReached
+			}
 			return nil, common.NewError("upload_error", "Failed to upload the file. "+err.Error())
 		}
 
@@ -1051,8 +1539,8 @@ func (fsh *StorageHandler) WriteFile(ctx context.Context, r *http.Request) (*Upl
 			formData.ThumbnailFilename = thumbInputData.Name
 		}
 
-		if allocationObj.BlobberSizeUsed+(allocationSize-existingFileRefSize) > allocationObj.BlobberSize {
-			return nil, common.NewError("max_allocation_size", "Max size reached for the allocation with this blobber")
+		if err := quota.Check(allocationSize); err != nil {
+			return nil, err
 		}
 
 		allocationChange := &allocation.AllocationChange{}
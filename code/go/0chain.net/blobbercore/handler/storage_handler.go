@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"0chain.net/core/encryption"
 	"github.com/gorilla/mux"
@@ -25,6 +26,9 @@ import (
 
 const (
 	FORM_FILE_PARSE_MAX_MEMORY = 10 * 1024 * 1024
+	// UPLOAD_FORM_ALLOWANCE is the size of the fields and the part headers
+	// of an upload form, over the one of its file and thumbnail.
+	UPLOAD_FORM_ALLOWANCE = 64 * 1024
 
 	DOWNLOAD_CONTENT_FULL  = "full"
 	DOWNLOAD_CONTENT_THUMB = "thumbnail"
@@ -285,9 +289,7 @@ func (fsh *StorageHandler) AddCollaborator(ctx context.Context, r *http.Request)
 		return nil, common.NewError("invalid_parameter", "collab_id not present in the params")
 	}
 
-	var result struct {
-		Msg string `json:"msg"`
-	}
+	var result CollaboratorResult
 
 	switch r.Method {
 	case http.MethodPost:
@@ -427,13 +429,29 @@ func (fsh *StorageHandler) ListEntities(ctx context.Context, r *http.Request) (*
 		}
 	}
 
-	dirref, err := reference.GetRefWithChildren(ctx, allocationID, fileref.Path)
+	page, err := listPageFromReq(r)
 	if err != nil {
-		return nil, common.NewError("invalid_parameters", "Invalid path. "+err.Error())
+		return nil, err
+	}
+	var (
+		dirref     *reference.Ref
+		nextCursor string
+	)
+	if page != nil {
+		dirref, nextCursor, err = reference.GetRefWithChildrenPage(ctx, allocationID, fileref.Path, page)
+		if err != nil {
+			return nil, common.NewError("invalid_parameters", "Invalid path. "+err.Error())
+		}
+	} else {
+		dirref, err = reference.GetRefWithChildren(ctx, allocationID, fileref.Path)
+		if err != nil {
+			return nil, common.NewError("invalid_parameters", "Invalid path. "+err.Error())
+		}
 	}
 
 	var result ListResult
 	result.AllocationRoot = allocationObj.AllocationRoot
+	result.NextCursor = nextCursor
 	result.Meta = dirref.GetListingData(ctx)
 	if clientID != allocationObj.OwnerID {
 		delete(result.Meta, "path")
@@ -449,6 +467,155 @@ func (fsh *StorageHandler) ListEntities(ctx context.Context, r *http.Request) (*
 	return &result, nil
 }
 
+// SearchEntities searches the files and directories under a directory of the
+// allocation by the filters of the request, a page at a time. The ones
+// other than the owner need an auth ticket of the directory or of one of its
+// parents.
+func (fsh *StorageHandler) SearchEntities(ctx context.Context, r *http.Request) (*SearchResult, error) {
+	if r.Method == "POST" {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use GET instead")
+	}
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, true)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+	allocationID := allocationObj.ID
+
+	if len(clientID) == 0 {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+
+	pathHash, err := pathHashFromReq(r, allocationID)
+	if err != nil {
+		return nil, err
+	}
+	dirref, err := reference.GetReferenceFromLookupHash(ctx, allocationID, pathHash)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid path. "+err.Error())
+	}
+	if dirref.Type != reference.DIRECTORY {
+		return nil, common.NewError("invalid_parameters", "Path is not a directory.")
+	}
+	authTokenString := r.FormValue("auth_token")
+	if clientID != allocationObj.OwnerID || len(authTokenString) > 0 {
+		authTicketVerified, err := fsh.verifyAuthTicket(ctx, authTokenString, allocationObj, dirref, clientID)
+		if err != nil {
+			return nil, err
+		}
+		if !authTicketVerified {
+			return nil, common.NewError("auth_ticket_verification_failed", "Could not verify the auth ticket.")
+		}
+	}
+
+	filter, err := searchFilterFromReq(r)
+	if err != nil {
+		return nil, err
+	}
+	filter.Path = dirref.Path
+	refs, next, err := reference.SearchRefs(ctx, allocationID, filter)
+	if err != nil {
+		return nil, common.NewError("search_error", err.Error())
+	}
+
+	result := &SearchResult{AllocationRoot: allocationObj.AllocationRoot}
+	result.Entities = make([]map[string]interface{}, len(refs))
+	for idx, ref := range refs {
+		result.Entities[idx] = ref.GetListingData(ctx)
+		if clientID != allocationObj.OwnerID {
+			delete(result.Entities[idx], "path")
+		}
+	}
+	if next > 0 {
+		result.NextCursor = strconv.FormatInt(next, 10)
+	}
+	return result, nil
+}
+
+// listPageFromReq reads the page of a listing, nil to list all the children
+// of the directory.
+func listPageFromReq(r *http.Request) (*reference.ListPage, error) {
+	page := &reference.ListPage{
+		SortBy: r.FormValue("sort_by"),
+		Cursor: r.FormValue("cursor"),
+	}
+	limit, err := formInt64(r, "limit")
+	if err != nil {
+		return nil, err
+	}
+	page.Limit = int(limit)
+	if page.SortBy == "" && page.Cursor == "" && page.Limit == 0 {
+		return nil, nil
+	}
+	return page, nil
+}
+
+// searchFilterFromReq reads the filters of a search, whose times are given
+// in unix seconds.
+func searchFilterFromReq(r *http.Request) (*reference.SearchFilter, error) {
+	filter := &reference.SearchFilter{
+		Name:       r.FormValue("name"),
+		MimeType:   r.FormValue("mimetype"),
+		Type:       r.FormValue("type"),
+		CustomMeta: r.FormValue("custom_meta"),
+	}
+	if filter.Type != "" && filter.Type != reference.FILE && filter.Type != reference.DIRECTORY {
+		return nil, common.NewError("invalid_parameters", "Invalid type")
+	}
+
+	var err error
+	if filter.MinSize, err = formInt64(r, "min_size"); err != nil {
+		return nil, err
+	}
+	if filter.MaxSize, err = formInt64(r, "max_size"); err != nil {
+		return nil, err
+	}
+	if filter.After, err = formInt64(r, "cursor"); err != nil {
+		return nil, err
+	}
+	if filter.CreatedAfter, err = formTime(r, "created_after"); err != nil {
+		return nil, err
+	}
+	if filter.CreatedBefore, err = formTime(r, "created_before"); err != nil {
+		return nil, err
+	}
+	if filter.UpdatedAfter, err = formTime(r, "updated_after"); err != nil {
+		return nil, err
+	}
+	if filter.UpdatedBefore, err = formTime(r, "updated_before"); err != nil {
+		return nil, err
+	}
+	limit, err := formInt64(r, "limit")
+	if err != nil {
+		return nil, err
+	}
+	filter.Limit = int(limit)
+	return filter, nil
+}
+
+// formInt64 reads a non negative integer of the form, zero if not set.
+func formInt64(r *http.Request, name string) (int64, error) {
+	value := r.FormValue(name)
+	if len(value) == 0 {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		return 0, common.NewError("invalid_parameters", "Invalid "+name)
+	}
+	return n, nil
+}
+
+// formTime reads a time of the form in unix seconds, zero if not set.
+func formTime(r *http.Request, name string) (time.Time, error) {
+	secs, err := formInt64(r, name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return unixTime(secs), nil
+}
+
 func (fsh *StorageHandler) GetReferencePath(ctx context.Context, r *http.Request) (*ReferencePathResult, error) {
 	resCh := make(chan *ReferencePathResult)
 	errCh := make(chan error)
@@ -498,7 +665,13 @@ func (fsh *StorageHandler) getReferencePath(ctx context.Context, r *http.Request
 		return
 	}
 
-	rootRef, err := reference.GetReferencePathFromPaths(ctx, allocationID, paths)
+	depth, err := formInt64(r, "depth")
+	if err != nil {
+		errCh <- err
+		return
+	}
+
+	rootRef, err := reference.GetReferencePathFromPathsDepth(ctx, allocationID, paths, int(depth))
 	if err != nil {
 		errCh <- err
 		return
@@ -623,7 +796,12 @@ func (fsh *StorageHandler) GetObjectTree(ctx context.Context, r *http.Request) (
 		return nil, common.NewError("invalid_parameters", "Invalid path")
 	}
 
-	rootRef, err := reference.GetObjectTree(ctx, allocationID, path)
+	depth, err := formInt64(r, "depth")
+	if err != nil {
+		return nil, err
+	}
+
+	rootRef, err := reference.GetObjectTreeDepth(ctx, allocationID, path, int(depth))
 	if err != nil {
 		return nil, err
 	}
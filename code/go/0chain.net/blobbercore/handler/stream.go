@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"0chain.net/blobbercore/filestore"
+)
+
+var errRangeNotSatisfiable = errors.New("range not satisfiable")
+
+// StreamResponse is a download response written straight to the client,
+// see common.ResponseStreamer.
+type StreamResponse struct {
+	Status int
+	Header http.Header
+
+	body   io.Reader
+	reader filestore.ObjectReader
+}
+
+func newStreamResponse(etag string) *StreamResponse {
+	sr := &StreamResponse{Status: http.StatusOK, Header: make(http.Header)}
+	sr.Header.Set("ETag", etag)
+	sr.Header.Set("Accept-Ranges", "bytes")
+	return sr
+}
+
+// StreamTo writes the headers and copies the content to the client.
+func (sr *StreamResponse) StreamTo(w http.ResponseWriter) error {
+	for key, values := range sr.Header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(sr.Status)
+	if sr.body == nil {
+		return nil
+	}
+	_, err := io.Copy(w, sr.body)
+	return err
+}
+
+// Close closes the underlying file store reader, if any.
+func (sr *StreamResponse) Close() error {
+	if sr.reader == nil {
+		return nil
+	}
+	return sr.reader.Close()
+}
+
+// parseRange parses the single "bytes=" range of a Range header against the
+// content size, returning the offset and the length to serve. An empty header
+// selects the whole content. Multiple ranges are not supported.
+func parseRange(header string, size int64) (start, length int64, err error) {
+	if header == "" {
+		return 0, size, nil
+	}
+	const prefix = "bytes="
+	if !strings.HasPrefix(header, prefix) {
+		return 0, 0, errRangeNotSatisfiable
+	}
+	spec := strings.TrimSpace(header[len(prefix):])
+	if strings.Contains(spec, ",") {
+		return 0, 0, errRangeNotSatisfiable
+	}
+	dash := strings.Index(spec, "-")
+	if dash < 0 {
+		return 0, 0, errRangeNotSatisfiable
+	}
+	startStr := strings.TrimSpace(spec[:dash])
+	endStr := strings.TrimSpace(spec[dash+1:])
+
+	if startStr == "" {
+		// suffix range, the last n bytes
+		n, err := strconv.ParseInt(endStr, 10, 64)
+		if err != nil || n <= 0 || size == 0 {
+			return 0, 0, errRangeNotSatisfiable
+		}
+		if n > size {
+			n = size
+		}
+		return size - n, n, nil
+	}
+
+	start, err = strconv.ParseInt(startStr, 10, 64)
+	if err != nil || start < 0 || start >= size {
+		return 0, 0, errRangeNotSatisfiable
+	}
+	end := size - 1
+	if endStr != "" {
+		end, err = strconv.ParseInt(endStr, 10, 64)
+		if err != nil || end < start {
+			return 0, 0, errRangeNotSatisfiable
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	return start, end - start + 1, nil
+}
+
+// blocksInRange returns the number of CHUNK_SIZE blocks the byte range
+// overlaps, which is what the read marker is debited for.
+func blocksInRange(start, length int64) int64 {
+	if length <= 0 {
+		return 0
+	}
+	return (start+length-1)/filestore.CHUNK_SIZE - start/filestore.CHUNK_SIZE + 1
+}
+
+// etagMatches reports whether the If-None-Match header matches the etag.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
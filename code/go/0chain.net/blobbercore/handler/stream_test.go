@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRange(t *testing.T) {
+	const size = 1000
+
+	tests := []struct {
+		header        string
+		start, length int64
+		wantErr       bool
+	}{
+		{header: "", start: 0, length: size},
+		{header: "bytes=0-99", start: 0, length: 100},
+		{header: "bytes=900-", start: 900, length: 100},
+		{header: "bytes=900-5000", start: 900, length: 100},
+		{header: "bytes=-10", start: 990, length: 10},
+		{header: "bytes=-5000", start: 0, length: size},
+		{header: "bytes=1000-", wantErr: true},
+		{header: "bytes=10-5", wantErr: true},
+		{header: "bytes=0-1,5-6", wantErr: true},
+		{header: "items=0-1", wantErr: true},
+		{header: "bytes=-0", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		start, length, err := parseRange(tc.header, size)
+		if tc.wantErr {
+			require.Error(t, err, tc.header)
+			continue
+		}
+		require.NoError(t, err, tc.header)
+		assert.Equal(t, tc.start, start, tc.header)
+		assert.Equal(t, tc.length, length, tc.header)
+	}
+}
+
+func TestBlocksInRange(t *testing.T) {
+	const block = 64 * 1024
+
+	assert.Equal(t, int64(0), blocksInRange(0, 0))
+	assert.Equal(t, int64(1), blocksInRange(0, 1))
+	assert.Equal(t, int64(1), blocksInRange(0, block))
+	assert.Equal(t, int64(2), blocksInRange(block-1, 2))
+	assert.Equal(t, int64(3), blocksInRange(10, 2*block))
+}
+
+func TestEtagMatches(t *testing.T) {
+	assert.True(t, etagMatches(`"abc"`, `"abc"`))
+	assert.True(t, etagMatches(`"x", W/"abc"`, `"abc"`))
+	assert.True(t, etagMatches(`*`, `"abc"`))
+	assert.False(t, etagMatches(``, `"abc"`))
+	assert.False(t, etagMatches(`"abd"`, `"abc"`))
+}
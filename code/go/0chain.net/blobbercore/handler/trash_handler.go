@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/constants"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/common"
+	"0chain.net/core/lock"
+	. "0chain.net/core/logging"
+
+	"go.uber.org/zap"
+)
+
+// ListTrash lists the files deleted from the allocation of the owner that
+// may still be restored, the latest deleted first.
+func (fsh *StorageHandler) ListTrash(ctx context.Context, r *http.Request) (*TrashResult, error) {
+	if r.Method == "GET" {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use POST instead")
+	}
+	if !allocation.TrashEnabled() {
+		return nil, common.NewError("trash_disabled", "The trash is disabled on the blobber")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, true)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	if len(clientID) == 0 || allocationObj.OwnerID != clientID {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+
+	refs, err := reference.GetTrash(ctx, allocationObj.ID, allocation.TrashExpiry())
+	if err != nil {
+		return nil, common.NewError("trash_error", err.Error())
+	}
+	result := &TrashResult{Files: make([]*TrashEntry, 0, len(refs))}
+	for _, ref := range refs {
+		result.Files = append(result.Files, &TrashEntry{
+			ID:          ref.ID,
+			Name:        ref.Name,
+			Path:        ref.Path,
+			Size:        ref.Size,
+			ContentHash: ref.ContentHash,
+			MimeType:    ref.MimeType,
+			DeletedAt:   ref.DeletedAt.Time,
+		})
+	}
+	return result, nil
+}
+
+// RestoreObject adds the restore of a file of the trash, by its reference
+// id, to the changes of the connection.
+func (fsh *StorageHandler) RestoreObject(ctx context.Context, r *http.Request) (interface{}, error) {
+	if r.Method == "GET" {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use POST instead")
+	}
+	if !allocation.TrashEnabled() {
+		return nil, common.NewError("trash_disabled", "The trash is disabled on the blobber")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, false)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+	allocationID := allocationObj.ID
+
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	if len(clientID) == 0 || allocationObj.OwnerID != clientID {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+	valid, err := verifySignatureFromRequest(r, allocationObj.OwnerPublicKey)
+	if !valid || err != nil {
+		return nil, common.NewError("invalid_signature", "Invalid signature")
+	}
+
+	refID, err := strconv.ParseInt(r.FormValue("id"), 10, 64)
+	if err != nil || refID <= 0 {
+		return nil, common.NewError("invalid_parameters", "Invalid reference id passed")
+	}
+
+	connectionID := r.FormValue("connection_id")
+	if len(connectionID) == 0 {
+		return nil, common.NewError("invalid_parameters", "Invalid connection id passed")
+	}
+
+	connectionObj, err := allocation.GetAllocationChanges(ctx, connectionID, allocationID, clientID)
+	if err != nil {
+		return nil, common.NewError("meta_error", "Error reading metadata for connection")
+	}
+
+	mutex := lock.GetMutex(connectionObj.TableName(), connectionID)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	objectRef, err := reference.GetTrashedRef(ctx, allocationID, refID, allocation.TrashExpiry())
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "File not found in the trash. "+err.Error())
+	}
+	if existingRef, _ := reference.GetReference(ctx, allocationID, objectRef.Path); existingRef != nil {
+		return nil, common.NewError("invalid_parameters", "Object already exists at path "+objectRef.Path)
+	}
+
+	allocationChange := &allocation.AllocationChange{}
+	allocationChange.ConnectionID = connectionObj.ConnectionID
+	allocationChange.Size = objectRef.Size
+	allocationChange.Operation = allocation.RESTORE_OPERATION
+	rfc := &allocation.RestoreFileChange{ConnectionID: connectionObj.ConnectionID,
+		AllocationID: connectionObj.AllocationID, RefID: objectRef.ID,
+		Path: objectRef.Path, Size: objectRef.Size}
+	connectionObj.Size += allocationChange.Size
+	connectionObj.AddChange(allocationChange, rfc)
+
+	err = connectionObj.Save(ctx)
+	if err != nil {
+		Logger.Error("Error in writing the connection meta data", zap.Error(err))
+		return nil, common.NewError("connection_write_error", "Error writing the connection meta data")
+	}
+
+	result := &UploadResult{}
+	result.Filename = objectRef.Name
+	result.Hash = objectRef.Hash
+	result.MerkleRoot = objectRef.MerkleRoot
+	result.Size = objectRef.Size
+
+	return result, nil
+}
@@ -3,13 +3,13 @@ package handler
 import (
 	"context"
 	"os"
-	"path/filepath"
 	"time"
 
 	"0chain.net/blobbercore/filestore"
 	"0chain.net/blobbercore/reference"
 	"0chain.net/blobbercore/stats"
 	"0chain.net/core/lock"
+	"0chain.net/core/metrics"
 
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/config"
@@ -21,7 +21,8 @@ import (
 
 func SetupWorkers(ctx context.Context) {
 	go CleanupTempFiles(ctx)
-	if config.Configuration.MinioStart {
+	// cold tiering only applies to content kept on the local disk
+	if config.Configuration.MinioStart && config.Configuration.FileStoreBackend == filestore.LocalStoreBackend {
 		go MoveColdDataToCloud(ctx)
 	}
 }
@@ -40,15 +41,36 @@ func CleanupDiskFiles(ctx context.Context) error {
 				Logger.Error("Error in cleanup of disk files.", zap.Error(err))
 				return
 			}
-			if len(refs) == 0 {
-				Logger.Info("hash has no references. Deleting from disk", zap.Any("count", len(refs)), zap.String("hash", contentHash))
-				if err := filestore.GetFileStore().DeleteFile(allocationObj.ID, contentHash); err != nil {
-					Logger.Error("FileStore_DeleteFile", zap.String("content_hash", contentHash), zap.Error(err))
-				}
+			if len(refs) > 0 {
+				return
+			}
+			// kept for a rollback, as a previous version of a file or in the trash
+			kept, err := reference.IsSnapshotObject(ctx, allocationObj.ID, contentHash)
+			if err == nil && !kept {
+				kept, err = reference.IsVersionObject(ctx, allocationObj.ID, contentHash)
+			}
+			if err == nil && !kept {
+				kept, err = allocation.IsTrashObject(ctx, allocationObj.ID, contentHash)
+			}
+			if err != nil {
+				Logger.Error("Error in cleanup of disk files.", zap.Error(err))
+				return
+			}
+			if kept {
+				return
+			}
+			Logger.Info("hash has no references. Deleting from disk", zap.Any("count", len(refs)), zap.String("hash", contentHash))
+			if err := filestore.GetFileStore().DeleteFile(allocationObj.ID, contentHash); err != nil {
+				Logger.Error("FileStore_DeleteFile", zap.String("content_hash", contentHash), zap.Error(err))
 			}
 		})
 		mutex.Unlock()
 	}
+	if oc, ok := filestore.GetFileStore().(filestore.OrphanCollector); ok {
+		if err := oc.RemoveOrphanedContent(); err != nil {
+			Logger.Error("FileStore_RemoveOrphanedContent", zap.Error(err))
+		}
+	}
 	return nil
 }
 
@@ -63,6 +85,7 @@ func CleanupTempFiles(ctx context.Context) {
 			//Logger.Info("Trying to redeem writemarkers.", zap.Any("iterInprogress", iterInprogress), zap.Any("numOfWorkers", numOfWorkers))
 			if !iterInprogress {
 				iterInprogress = true //nolint:ineffassign // probably has something to do with goroutines
+				start := time.Now()
 				rctx := datastore.GetStore().CreateTransaction(ctx)
 				db := datastore.GetStore().GetTransaction(rctx)
 				now := time.Now()
@@ -85,6 +108,7 @@ func CleanupTempFiles(ctx context.Context) {
 				}
 				db.Rollback()
 				rctx.Done()
+				metrics.ObserveWorkerLoop("cleanup_temp_files", start)
 				iterInprogress = false
 			}
 		}
@@ -102,6 +126,7 @@ func MoveColdDataToCloud(ctx context.Context) {
 			return
 		case <-ticker.C:
 			if !iterInprogress {
+				start := time.Now()
 				fs := filestore.GetFileStore()
 				totalDiskSizeUsed, err := fs.GetTotalDiskSizeUsed()
 				if err != nil {
@@ -152,6 +177,7 @@ func MoveColdDataToCloud(ctx context.Context) {
 				}
 				iterInprogress = false
 				stats.LastMinioScan = time.Now()
+				metrics.ObserveWorkerLoop("move_cold_data", start)
 				Logger.Info("Move cold data to cloud worker running successfully")
 			}
 		}
@@ -159,20 +185,15 @@ func MoveColdDataToCloud(ctx context.Context) {
 }
 
 func moveFileToCloud(ctx context.Context, fileRef *reference.Ref) {
-	fs := filestore.GetFileStore()
-	allocation, err := fs.SetupAllocation(fileRef.AllocationID, true)
-	if err != nil {
-		Logger.Error("Unable to fetch allocation with error", zap.Any("allocationID", fileRef.AllocationID), zap.Error(err))
+	// the other stores keep no local objects to move
+	uploader, ok := filestore.GetFileStore().(filestore.ObjectUploader)
+	if !ok {
 		return
 	}
 
-	dirPath, destFile := filestore.GetFilePathFromHash(fileRef.ContentHash)
-	fileObjectPath := filepath.Join(allocation.ObjectsPath, dirPath)
-	fileObjectPath = filepath.Join(fileObjectPath, destFile)
-
-	err = fs.UploadToCloud(fileRef.ContentHash, fileObjectPath)
+	fileObjectPath, err := uploader.UploadObjectToCloud(fileRef.AllocationID, &filestore.FileInputData{Hash: fileRef.ContentHash})
 	if err != nil {
-		Logger.Error("Error uploading cold data to cloud", zap.Error(err), zap.Any("file_name", fileRef.Name), zap.Any("file_path", fileObjectPath))
+		Logger.Error("Error uploading cold data to cloud", zap.Error(err), zap.Any("file_name", fileRef.Name), zap.Any("allocation", fileRef.AllocationID))
 		return
 	}
 
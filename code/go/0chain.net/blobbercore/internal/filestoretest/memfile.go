@@ -0,0 +1,18 @@
+// Package filestoretest provides the helpers of the tests writing to the
+// file stores.
+package filestoretest
+
+import (
+	"bytes"
+)
+
+// MemFile is a file of content in memory, to write to the stores.
+type MemFile struct {
+	*bytes.Reader
+}
+
+func NewMemFile(content []byte) *MemFile {
+	return &MemFile{bytes.NewReader(content)}
+}
+
+func (*MemFile) Close() error { return nil }
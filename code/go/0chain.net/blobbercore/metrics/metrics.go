@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"database/sql"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/datastore"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	Success = "success"
+	Failure = "failure"
+)
+
+var (
+	// WriteMarkerRedeems counts write marker redeem attempts by result.
+	WriteMarkerRedeems = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blobber_writemarker_redeems_total",
+		Help: "Number of write marker redeem attempts, by result.",
+	}, []string{"result"})
+
+	// ReadMarkerRedeems counts read marker redeem attempts by result.
+	ReadMarkerRedeems = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blobber_readmarker_redeems_total",
+		Help: "Number of read marker redeem attempts, by result.",
+	}, []string{"result"})
+
+	// ChallengeOutcomes counts the challenges processed by the blobber by
+	// outcome: success or failure as decided by the validators, no_consensus,
+	// committed once submitted to the blockchain and commit_error.
+	ChallengeOutcomes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blobber_challenges_total",
+		Help: "Number of processed challenges, by outcome.",
+	}, []string{"outcome"})
+
+	// ChallengeStages observes the time the challenges take by stage: wait
+	// from their creation on the blockchain to their first validation,
+	// validation for every attempt, commit for every submission, and
+	// response from their creation to their commit.
+	ChallengeStages = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "blobber_challenge_stage_duration_seconds",
+		Help:    "Time the challenges take, by stage.",
+		Buckets: []float64{.1, .5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600},
+	}, []string{"stage"})
+)
+
+func init() {
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "blobber_open_connections",
+		Help: "Number of allocation write connections not yet committed.",
+	}, countOpenConnections)
+
+	dbStat := func(name, help string, value func(s sql.DBStats) float64) {
+		promauto.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "blobber_db_" + name,
+			Help: help,
+		}, func() float64 {
+			s, ok := getDBStats()
+			if !ok {
+				return 0
+			}
+			return value(s)
+		})
+	}
+	dbStat("open_connections", "Number of established metadata store connections.",
+		func(s sql.DBStats) float64 { return float64(s.OpenConnections) })
+	dbStat("in_use_connections", "Number of metadata store connections in use.",
+		func(s sql.DBStats) float64 { return float64(s.InUse) })
+	dbStat("idle_connections", "Number of idle metadata store connections.",
+		func(s sql.DBStats) float64 { return float64(s.Idle) })
+	dbStat("max_open_connections", "Maximum number of open metadata store connections.",
+		func(s sql.DBStats) float64 { return float64(s.MaxOpenConnections) })
+	dbStat("wait_count", "Total number of waits for a metadata store connection.",
+		func(s sql.DBStats) float64 { return float64(s.WaitCount) })
+	dbStat("wait_duration_seconds", "Total time blocked waiting for a metadata store connection.",
+		func(s sql.DBStats) float64 { return s.WaitDuration.Seconds() })
+}
+
+/*Redeemed - records the result of a marker redeem on the counter */
+func Redeemed(counter *prometheus.CounterVec, err error) {
+	if err != nil {
+		counter.WithLabelValues(Failure).Inc()
+		return
+	}
+	counter.WithLabelValues(Success).Inc()
+}
+
+func countOpenConnections() float64 {
+	db := datastore.GetStore().GetDB()
+	if db == nil {
+		return 0
+	}
+	var count int64
+	err := db.Table((&allocation.AllocationChangeCollector{}).TableName()).
+		Where("status IN (?,?)", allocation.NewConnection, allocation.InProgressConnection).
+		Count(&count).Error
+	if err != nil {
+		return 0
+	}
+	return float64(count)
+}
+
+func getDBStats() (sql.DBStats, bool) {
+	db := datastore.GetStore().GetDB()
+	if db == nil {
+		return sql.DBStats{}, false
+	}
+	sqldb, err := db.DB()
+	if err != nil {
+		return sql.DBStats{}, false
+	}
+	return sqldb.Stats(), true
+}
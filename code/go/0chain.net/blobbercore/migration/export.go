@@ -0,0 +1,216 @@
+package migration
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"io"
+	"path"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/readmarker"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/blobbercore/writemarker"
+	"0chain.net/core/common"
+)
+
+const (
+	manifestName = "manifest.json"
+	objectsDir   = "objects"
+)
+
+// Manifest is the metadata of an exported allocation. It is the first entry
+// of the archive, followed by the objects of its files.
+type Manifest struct {
+	Allocation     *allocation.Allocation           `json:"allocation"`
+	Refs           []*reference.Ref                 `json:"refs"`
+	CommitMetaTxns []*reference.CommitMetaTxn       `json:"commit_meta_txns"`
+	Collaborators  []*reference.Collaborator        `json:"collaborators"`
+	WriteMarkers   []*writemarker.WriteMarkerEntity `json:"write_markers"`
+	ReadMarkers    []*readmarker.ReadMarkerEntity   `json:"read_markers"`
+}
+
+// object is the content or the thumbnail of a file, stored under its hash.
+type object struct {
+	Hash       string
+	MerkleRoot string
+	MimeType   string
+	OnCloud    bool
+}
+
+// objects lists the objects of the files of the manifest, once per hash.
+func (m *Manifest) objects() []*object {
+	var (
+		list []*object
+		seen = make(map[string]bool)
+	)
+	add := func(o *object) {
+		if o.Hash == "" || seen[o.Hash] {
+			return
+		}
+		seen[o.Hash] = true
+		list = append(list, o)
+	}
+	for _, ref := range m.Refs {
+		if ref.Type != reference.FILE {
+			continue
+		}
+		add(&object{
+			Hash:       ref.ContentHash,
+			MerkleRoot: ref.MerkleRoot,
+			MimeType:   ref.MimeType,
+			OnCloud:    ref.OnCloud,
+		})
+		if ref.ThumbnailSize > 0 {
+			add(&object{Hash: ref.ThumbnailHash, OnCloud: ref.OnCloud})
+		}
+	}
+	return list
+}
+
+// latestWriteMarker returns the write marker of the allocation root.
+func (m *Manifest) latestWriteMarker() *writemarker.WriteMarkerEntity {
+	for _, wm := range m.WriteMarkers {
+		if wm.WM.AllocationRoot == m.Allocation.AllocationRoot {
+			return wm
+		}
+	}
+	return nil
+}
+
+// Export writes the allocation to w as a tar archive of its manifest and the
+// objects of its files. Only an allocation with all of its markers redeemed
+// can be exported.
+func Export(ctx context.Context, allocationID string, w io.Writer) (
+	*Manifest, error) {
+
+	m, err := loadManifest(ctx, allocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	tw := tar.NewWriter(w)
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, common.NewError("export_error", err.Error())
+	}
+	err = tw.WriteHeader(&tar.Header{
+		Name: manifestName,
+		Mode: 0600,
+		Size: int64(len(data)),
+	})
+	if err == nil {
+		_, err = tw.Write(data)
+	}
+	if err != nil {
+		return nil, common.NewError("export_error", err.Error())
+	}
+
+	for _, o := range m.objects() {
+		if err = exportObject(tw, allocationID, o); err != nil {
+			return nil, err
+		}
+	}
+	if err = tw.Close(); err != nil {
+		return nil, common.NewError("export_error", err.Error())
+	}
+	return m, nil
+}
+
+func exportObject(tw *tar.Writer, allocationID string, o *object) error {
+	reader, size, err := filestore.GetFileStore().GetFileReader(allocationID,
+		&filestore.FileInputData{Hash: o.Hash, OnCloud: o.OnCloud})
+	if err != nil {
+		return common.NewErrorf("export_error",
+			"reading object %s: %v", o.Hash, err)
+	}
+	defer reader.Close()
+
+	err = tw.WriteHeader(&tar.Header{
+		Name: path.Join(objectsDir, o.Hash),
+		Mode: 0600,
+		Size: size,
+	})
+	if err == nil {
+		_, err = io.Copy(tw, reader)
+	}
+	if err != nil {
+		return common.NewErrorf("export_error",
+			"writing object %s: %v", o.Hash, err)
+	}
+	return nil
+}
+
+func loadManifest(ctx context.Context, allocationID string) (
+	*Manifest, error) {
+
+	var (
+		db = datastore.GetStore().GetTransaction(ctx)
+		m  = &Manifest{Allocation: new(allocation.Allocation)}
+	)
+
+	err := db.Where("id = ?", allocationID).First(m.Allocation).Error
+	if err != nil {
+		return nil, common.NewError("invalid_allocation", err.Error())
+	}
+	switch a := m.Allocation; {
+	case a.Finalized || a.CleanedUp:
+		return nil, common.NewError("allocation_finalized",
+			"The allocation is finalized")
+	case a.AllocationRoot == "":
+		return nil, common.NewError("empty_allocation",
+			"The allocation has no data to migrate")
+	case a.IsRedeemRequired:
+		return nil, common.NewError("redeem_required",
+			"The write markers of the allocation are not redeemed yet")
+	}
+
+	err = db.Where("allocation_id = ?", allocationID).
+		Order("level, lookup_hash").Find(&m.Refs).Error
+	if err != nil {
+		return nil, common.NewError("export_error", err.Error())
+	}
+	var refIDs = make([]int64, 0, len(m.Refs))
+	for _, ref := range m.Refs {
+		refIDs = append(refIDs, ref.ID)
+		ref.CommitMetaTxns = nil
+	}
+	if len(refIDs) > 0 {
+		err = db.Where("ref_id IN ?", refIDs).Find(&m.CommitMetaTxns).Error
+		if err == nil {
+			err = db.Where("ref_id IN ?", refIDs).Find(&m.Collaborators).Error
+		}
+		if err != nil {
+			return nil, common.NewError("export_error", err.Error())
+		}
+	}
+
+	err = db.Where("allocation_id = ?", allocationID).
+		Order("sequence").Find(&m.WriteMarkers).Error
+	if err == nil {
+		err = db.Where("allocation_id = ?", allocationID).
+			Find(&m.ReadMarkers).Error
+	}
+	if err != nil {
+		return nil, common.NewError("export_error", err.Error())
+	}
+	for _, wm := range m.WriteMarkers {
+		if wm.Status != writemarker.Committed {
+			return nil, common.NewError("redeem_required",
+				"The write markers of the allocation are not redeemed yet")
+		}
+	}
+	for _, rm := range m.ReadMarkers {
+		if rm.RedeemRequired {
+			return nil, common.NewError("redeem_required",
+				"The read markers of the allocation are not redeemed yet")
+		}
+	}
+	if m.latestWriteMarker() == nil {
+		return nil, common.NewError("export_error",
+			"The write marker of the allocation root is missing")
+	}
+	return m, nil
+}
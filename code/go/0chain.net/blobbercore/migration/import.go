@@ -0,0 +1,340 @@
+package migration
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	. "0chain.net/core/logging"
+	"0chain.net/core/node"
+	"0chain.net/core/transaction"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var objectHashRE = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// ImportResult is the allocation root computed by the blobber which imported
+// the allocation.
+type ImportResult struct {
+	AllocationID   string `json:"allocation_id"`
+	AllocationRoot string `json:"allocation_root"`
+}
+
+// Import reads an archive written by Export and stores the allocation, which
+// must be new to this blobber and be one of its allocations on the chain. The
+// metadata is written in the transaction of the context. The objects are
+// stored only once the allocation root computed from the metadata matches
+// the one of the export, and are deleted again on error.
+func Import(ctx context.Context, sa *transaction.StorageAllocation,
+	r io.Reader) (*ImportResult, error) {
+
+	tr := tar.NewReader(r)
+	hdr, err := tr.Next()
+	if err != nil || hdr.Name != manifestName {
+		return nil, common.NewError("invalid_archive",
+			"The archive does not start with the manifest")
+	}
+	var m Manifest
+	if err = json.NewDecoder(tr).Decode(&m); err != nil {
+		return nil, common.NewError("invalid_archive", err.Error())
+	}
+	if m.Allocation == nil || m.Allocation.ID != sa.ID {
+		return nil, common.NewError("invalid_archive",
+			"The archive is not of the allocation")
+	}
+
+	if err = importMetadata(ctx, sa, &m); err != nil {
+		return nil, err
+	}
+
+	objects := make(map[string]*object)
+	for _, o := range m.objects() {
+		objects[o.Hash] = o
+	}
+	var stored []string
+	if err = importObjects(tr, sa.ID, objects, &stored); err != nil {
+		for _, hash := range stored {
+			if derr := filestore.GetFileStore().DeleteFile(sa.ID, hash); derr != nil {
+				Logger.Error("deleting imported object", zap.String("hash", hash),
+					zap.Error(derr))
+			}
+		}
+		return nil, err
+	}
+
+	return &ImportResult{
+		AllocationID:   sa.ID,
+		AllocationRoot: m.Allocation.AllocationRoot,
+	}, nil
+}
+
+func importMetadata(ctx context.Context, sa *transaction.StorageAllocation,
+	m *Manifest) error {
+
+	var (
+		db = datastore.GetStore().GetTransaction(ctx)
+		a  = m.Allocation
+	)
+
+	existing := new(allocation.Allocation)
+	err := db.Where("id = ?", sa.ID).First(existing).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return common.NewError("import_error", err.Error())
+	}
+	if err == nil && existing.AllocationRoot != "" {
+		return common.NewError("allocation_exists",
+			"The allocation already has data on this blobber")
+	}
+
+	var isBlobber bool
+	for _, b := range sa.Blobbers {
+		if b.ID == node.Self.ID {
+			isBlobber = true
+			break
+		}
+	}
+	if !isBlobber {
+		return common.NewError("invalid_blobber",
+			"Blobber is not part of the allocation")
+	}
+
+	latest := m.latestWriteMarker()
+	if latest == nil {
+		return common.NewError("invalid_archive",
+			"The write marker of the allocation root is missing")
+	}
+	if err = verifyWriteMarkerClient(m, sa, latest.WM.ClientID,
+		latest.ClientPublicKey); err != nil {
+		return err
+	}
+	sigOK, err := encryption.Verify(latest.ClientPublicKey,
+		latest.WM.Signature, encryption.Hash(latest.WM.GetHashData()))
+	if err != nil || !sigOK {
+		return common.NewError("invalid_write_marker",
+			"The signature of the latest write marker is not valid")
+	}
+
+	// the chain is the authority for the terms of the allocation
+	a.Tx = sa.Tx
+	a.Expiration = sa.Expiration
+	a.OwnerID = sa.OwnerID
+	a.OwnerPublicKey = sa.OwnerPublicKey
+	a.TotalSize = sa.Size
+	a.UsedSize = sa.UsedSize
+	a.Finalized = sa.Finalized
+	a.TimeUnit = sa.TimeUnit
+	a.BlobberSize = (sa.Size + int64(len(sa.Blobbers)-1)) /
+		int64(len(sa.Blobbers))
+	a.IsRedeemRequired = false
+	a.CleanedUp = false
+	if err = db.Save(a).Error; err != nil {
+		return common.NewError("import_error", err.Error())
+	}
+	err = db.Where("allocation_id = ?", a.ID).Delete(&allocation.Terms{}).Error
+	if err != nil {
+		return common.NewError("import_error", err.Error())
+	}
+	for _, d := range sa.BlobberDetails {
+		err = db.Create(&allocation.Terms{
+			BlobberID:    d.BlobberID,
+			AllocationID: a.ID,
+			ReadPrice:    d.Terms.ReadPrice,
+			WritePrice:   d.Terms.WritePrice,
+		}).Error
+		if err != nil {
+			return common.NewError("import_error", err.Error())
+		}
+	}
+
+	// the refs get new ids on this blobber
+	var refIDs = make(map[int64]int64, len(m.Refs))
+	for _, ref := range m.Refs {
+		if ref.AllocationID != a.ID {
+			return common.NewError("invalid_archive",
+				"The reference is not of the allocation")
+		}
+		oldID := ref.ID
+		ref.ID = 0
+		ref.OnCloud = false // the objects are stored locally
+		ref.CommitMetaTxns = nil
+		// JSON turns the unset columns into a zero time and a null
+		ref.DeletedAt = gorm.DeletedAt{}
+		if string(ref.Attributes) == "null" {
+			ref.Attributes = nil
+		}
+		if err = db.Create(ref).Error; err != nil {
+			return common.NewError("import_error", err.Error())
+		}
+		refIDs[oldID] = ref.ID
+	}
+	for _, txn := range m.CommitMetaTxns {
+		if txn.RefID = refIDs[txn.RefID]; txn.RefID == 0 {
+			return common.NewError("invalid_archive",
+				"The commit meta transaction is of an unknown reference")
+		}
+		if err = db.Create(txn).Error; err != nil {
+			return common.NewError("import_error", err.Error())
+		}
+	}
+	for _, c := range m.Collaborators {
+		if c.RefID = refIDs[c.RefID]; c.RefID == 0 {
+			return common.NewError("invalid_archive",
+				"The collaborator is of an unknown reference")
+		}
+		if err = db.Create(c).Error; err != nil {
+			return common.NewError("import_error", err.Error())
+		}
+	}
+
+	for _, wm := range m.WriteMarkers {
+		if wm.WM.AllocationID != a.ID {
+			return common.NewError("invalid_archive",
+				"The write marker is not of the allocation")
+		}
+		if err = db.Create(wm).Error; err != nil {
+			return common.NewError("import_error", err.Error())
+		}
+	}
+	for _, rm := range m.ReadMarkers {
+		if rm.LatestRM == nil || rm.LatestRM.AllocationID != a.ID {
+			return common.NewError("invalid_archive",
+				"The read marker is not of the allocation")
+		}
+		// the read markers are per client, keep the ones known here
+		err = db.Clauses(clause.OnConflict{DoNothing: true}).Create(rm).Error
+		if err != nil {
+			return common.NewError("import_error", err.Error())
+		}
+	}
+
+	rootRef, err := reference.GetObjectTree(ctx, a.ID, "/")
+	if err != nil {
+		return common.NewError("import_error", err.Error())
+	}
+	if _, err = rootRef.CalculateHash(ctx, false); err != nil {
+		return common.NewError("import_error", err.Error())
+	}
+	root := encryption.Hash(rootRef.Hash + ":" +
+		strconv.FormatInt(int64(latest.WM.Timestamp), 10))
+	if root != a.AllocationRoot {
+		return common.NewErrorf("allocation_root_mismatch",
+			"computed allocation root %s, expected %s", root, a.AllocationRoot)
+	}
+	// the previous versions of the files are not migrated
+	err = db.Model(a).Update("blobber_size_used", rootRef.Size).Error
+	if err != nil {
+		return common.NewError("import_error", err.Error())
+	}
+	return nil
+}
+
+// verifyWriteMarkerClient checks the writer is the owner of the allocation,
+// or a collaborator, and holds the key.
+func verifyWriteMarkerClient(m *Manifest, sa *transaction.StorageAllocation,
+	clientID, clientKey string) error {
+
+	clientKeyBytes, _ := hex.DecodeString(clientKey)
+	if encryption.Hash(clientKeyBytes) != clientID {
+		return common.NewError("invalid_write_marker",
+			"The key of the latest write marker is not of its client")
+	}
+	if clientID == sa.OwnerID {
+		return nil
+	}
+	for _, c := range m.Collaborators {
+		if c.ClientID == clientID {
+			return nil
+		}
+	}
+	return common.NewError("invalid_write_marker",
+		"The latest write marker is not by the owner or a collaborator")
+}
+
+func importObjects(tr *tar.Reader, allocationID string,
+	objects map[string]*object, stored *[]string) error {
+
+	var connectionID = "migration." + strconv.FormatInt(int64(common.Now()), 10)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return common.NewError("invalid_archive", err.Error())
+		}
+		dir, hash := path.Split(hdr.Name)
+		o, ok := objects[hash]
+		if path.Clean(dir) != objectsDir || !objectHashRE.MatchString(hash) || !ok {
+			return common.NewErrorf("invalid_archive",
+				"unexpected archive entry %s", hdr.Name)
+		}
+		if err = importObject(tr, allocationID, o, connectionID); err != nil {
+			return err
+		}
+		*stored = append(*stored, hash)
+		delete(objects, hash)
+	}
+	if len(objects) > 0 {
+		return common.NewErrorf("invalid_archive",
+			"%d objects are missing", len(objects))
+	}
+	return nil
+}
+
+func importObject(r io.Reader, allocationID string, o *object,
+	connectionID string) error {
+
+	// the file stores take a seekable file
+	tmp, err := ioutil.TempFile("", "import")
+	if err != nil {
+		return common.NewError("import_error", err.Error())
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	if _, err = io.Copy(tmp, r); err == nil {
+		_, err = tmp.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		return common.NewError("import_error", err.Error())
+	}
+
+	fs := filestore.GetFileStore()
+	fileData := &filestore.FileInputData{
+		Name:     o.Hash,
+		Path:     "/",
+		Hash:     o.Hash,
+		MimeType: o.MimeType,
+	}
+	out, err := fs.WriteFile(allocationID, fileData, tmp, connectionID)
+	if err != nil {
+		return common.NewError("import_error", err.Error())
+	}
+	if out.ContentHash != o.Hash ||
+		(o.MerkleRoot != "" && out.MerkleRoot != o.MerkleRoot) {
+
+		_ = fs.DeleteTempFile(allocationID, fileData, connectionID)
+		return common.NewErrorf("content_hash_mismatch",
+			"the content of object %s does not match its hash", o.Hash)
+	}
+	if _, err = fs.CommitWrite(allocationID, fileData, connectionID); err != nil {
+		return common.NewError("import_error", err.Error())
+	}
+	return nil
+}
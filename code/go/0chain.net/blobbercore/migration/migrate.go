@@ -0,0 +1,101 @@
+// Package migration moves an allocation, with its reference tree, markers and
+// objects, from this blobber to a peer blobber of the allocation.
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/core/common"
+	. "0chain.net/core/logging"
+
+	"go.uber.org/zap"
+)
+
+// ImportPath is the endpoint of the peer blobbers which imports an archive,
+// followed by the allocation transaction.
+const ImportPath = "/v1/allocation/import/"
+
+// Migrate streams the allocation to the blobber at the URL. Once the peer has
+// stored it with the same allocation root, the allocation is cleaned up on
+// this blobber. The header carries the credentials of the owner, which the
+// peer checks.
+func Migrate(ctx context.Context, a *allocation.Allocation,
+	blobberURL string, header http.Header) (*ImportResult, error) {
+
+	var (
+		pr, pw = io.Pipe()
+		errc   = make(chan error, 1)
+	)
+	go func() {
+		_, err := Export(ctx, a.ID, pw)
+		pw.CloseWithError(err)
+		errc <- err
+	}()
+
+	res, err := postArchive(ctx, a, blobberURL, header, pr)
+	pr.Close()
+	// the export fails on the pipe closed when the post does, the error of
+	// the peer is the one reported
+	exportErr := <-errc
+	if err != nil {
+		return nil, err
+	}
+	if exportErr != nil {
+		return nil, exportErr
+	}
+
+	if res.AllocationRoot != a.AllocationRoot {
+		return nil, common.NewErrorf("allocation_root_mismatch",
+			"peer blobber has allocation root %s, expected %s",
+			res.AllocationRoot, a.AllocationRoot)
+	}
+
+	Logger.Info("Allocation migrated, cleaning up",
+		zap.String("allocation", a.ID), zap.String("blobber", blobberURL))
+	allocation.CleanupAllocation(ctx, a)
+	return res, nil
+}
+
+func postArchive(ctx context.Context, a *allocation.Allocation,
+	blobberURL string, header http.Header, body io.Reader) (
+	*ImportResult, error) {
+
+	u := strings.TrimSuffix(blobberURL, "/") + ImportPath + a.Tx + "?" +
+		url.Values{"allocation_id": {a.ID}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, common.NewError("migration_failed", err.Error())
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/x-tar")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, common.NewError("migration_failed", err.Error())
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, common.NewError("migration_failed", err.Error())
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, common.NewErrorf("migration_failed",
+			"peer blobber responded with %s: %s", resp.Status,
+			strings.TrimSpace(string(data)))
+	}
+	var res ImportResult
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, common.NewError("migration_failed", err.Error())
+	}
+	return &res, nil
+}
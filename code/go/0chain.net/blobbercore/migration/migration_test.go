@@ -0,0 +1,306 @@
+package migration
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/internal/filestoretest"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/blobbercore/writemarker"
+	"0chain.net/core/common"
+	"0chain.net/core/config"
+	"0chain.net/core/encryption"
+	"0chain.net/core/logging"
+	"0chain.net/core/node"
+	"0chain.net/core/transaction"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	testAllocationID = "migrated_allocation"
+	sourceBlobberID  = "source_blobber"
+	targetBlobberID  = "target_blobber"
+	fileContent      = "the content of the migrated file"
+)
+
+func init() {
+	logging.Logger = zap.NewNop()
+	config.Configuration.SignatureScheme = "bls0chain"
+}
+
+// setupBlobber starts a blobber with an empty metadata and file store.
+func setupBlobber(t *testing.T, blobberID string) context.Context {
+	datastore.OpenTheSQLiteStore(t)
+	_, err := filestore.SetupStore(filestore.MemoryStoreBackend, "")
+	require.NoError(t, err)
+	node.Self.ID = blobberID
+	return datastore.GetStore().CreateTransaction(context.Background())
+}
+
+// storeAllocation stores an allocation of a single file, with its write
+// marker signed by the owner.
+func storeAllocation(t *testing.T, ctx context.Context,
+	owner zcncrypto.SignatureScheme) *allocation.Allocation {
+
+	var (
+		db        = datastore.GetStore().GetTransaction(ctx)
+		fs        = filestore.GetFileStore()
+		ownerKey  = owner.GetPublicKey()
+		keyBytes  = mustDecodeHex(t, ownerKey)
+		timestamp = common.Now()
+	)
+
+	fileData := &filestore.FileInputData{Name: "file.txt", Path: "/file.txt"}
+	out, err := fs.WriteFile(testAllocationID, fileData,
+		filestoretest.NewMemFile([]byte(fileContent)), "upload")
+	require.NoError(t, err)
+	fileData.Hash = out.ContentHash
+	_, err = fs.CommitWrite(testAllocationID, fileData, "upload")
+	require.NoError(t, err)
+
+	root := &reference.Ref{Type: reference.DIRECTORY,
+		AllocationID: testAllocationID, Name: "/", Path: "/", PathLevel: 1,
+		LookupHash: reference.GetReferenceLookup(testAllocationID, "/")}
+	file := &reference.Ref{Type: reference.FILE,
+		AllocationID: testAllocationID, Name: "file.txt", Path: "/file.txt",
+		ParentPath: "/", PathLevel: 2, Size: out.Size,
+		ContentHash: out.ContentHash, MerkleRoot: out.MerkleRoot,
+		LookupHash: reference.GetReferenceLookup(testAllocationID, "/file.txt")}
+	for _, ref := range []*reference.Ref{root, file} {
+		ref.PathHash = ref.LookupHash
+		require.NoError(t, db.Create(ref).Error)
+	}
+	tree, err := reference.GetObjectTree(ctx, testAllocationID, "/")
+	require.NoError(t, err)
+	_, err = tree.CalculateHash(ctx, true)
+	require.NoError(t, err)
+
+	wm := &writemarker.WriteMarkerEntity{
+		WM: writemarker.WriteMarker{
+			AllocationRoot: encryption.Hash(tree.Hash + ":" +
+				strconv.FormatInt(int64(timestamp), 10)),
+			AllocationID: testAllocationID,
+			Size:         out.Size,
+			BlobberID:    sourceBlobberID,
+			Timestamp:    timestamp,
+			ClientID:     encryption.Hash(keyBytes),
+		},
+		Status:          writemarker.Committed,
+		ClientPublicKey: ownerKey,
+	}
+	wm.WM.Signature, err = owner.Sign(encryption.Hash(wm.WM.GetHashData()))
+	require.NoError(t, err)
+	require.NoError(t, db.Create(wm).Error)
+
+	a := &allocation.Allocation{
+		ID:              testAllocationID,
+		Tx:              testAllocationID,
+		OwnerID:         wm.WM.ClientID,
+		OwnerPublicKey:  ownerKey,
+		AllocationRoot:  wm.WM.AllocationRoot,
+		BlobberSizeUsed: out.Size,
+		Expiration:      common.Timestamp(time.Now().Add(time.Hour).Unix()),
+		TimeUnit:        time.Hour,
+	}
+	require.NoError(t, db.Create(a).Error)
+	return a
+}
+
+func storageAllocation(a *allocation.Allocation) *transaction.StorageAllocation {
+	return &transaction.StorageAllocation{
+		ID:             a.ID,
+		Tx:             a.Tx,
+		OwnerID:        a.OwnerID,
+		OwnerPublicKey: a.OwnerPublicKey,
+		Size:           2 << 20,
+		Expiration:     a.Expiration,
+		TimeUnit:       a.TimeUnit,
+		Blobbers: []*transaction.StorageNode{
+			{ID: targetBlobberID}, {ID: "other_blobber"},
+		},
+	}
+}
+
+func newOwner(t *testing.T) zcncrypto.SignatureScheme {
+	owner := zcncrypto.NewSignatureScheme("bls0chain")
+	_, err := owner.GenerateKeys()
+	require.NoError(t, err)
+	return owner
+}
+
+func exportAllocation(t *testing.T, owner zcncrypto.SignatureScheme) (
+	*allocation.Allocation, []byte) {
+
+	ctx := setupBlobber(t, sourceBlobberID)
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+	defer datastore.GetStore().Close()
+
+	a := storeAllocation(t, ctx, owner)
+	var archive bytes.Buffer
+	m, err := Export(ctx, a.ID, &archive)
+	require.NoError(t, err)
+	assert.Len(t, m.Refs, 2)
+	assert.Len(t, m.WriteMarkers, 1)
+	return a, archive.Bytes()
+}
+
+func TestImport_RoundTrip(t *testing.T) {
+	a, archive := exportAllocation(t, newOwner(t))
+
+	ctx := setupBlobber(t, targetBlobberID)
+	defer datastore.GetStore().Close()
+
+	res, err := Import(ctx, storageAllocation(a), bytes.NewReader(archive))
+	require.NoError(t, err)
+	require.NoError(t, datastore.GetStore().GetTransaction(ctx).Commit().Error)
+	assert.Equal(t, a.AllocationRoot, res.AllocationRoot)
+
+	ctx = datastore.GetStore().CreateTransaction(context.Background())
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	imported := new(allocation.Allocation)
+	require.NoError(t, datastore.GetStore().GetTransaction(ctx).
+		Where("id = ?", a.ID).First(imported).Error)
+	assert.Equal(t, a.AllocationRoot, imported.AllocationRoot)
+	assert.Equal(t, int64(1<<20), imported.BlobberSize)
+
+	ref, err := reference.GetReference(ctx, a.ID, "/file.txt")
+	require.NoError(t, err)
+	reader, size, err := filestore.GetFileStore().GetFileReader(a.ID,
+		&filestore.FileInputData{Hash: ref.ContentHash})
+	require.NoError(t, err)
+	defer reader.Close()
+	assert.Equal(t, int64(len(fileContent)), size)
+
+	wm, err := writemarker.GetWriteMarkerEntity(ctx, a.AllocationRoot)
+	require.NoError(t, err)
+	assert.Equal(t, sourceBlobberID, wm.WM.BlobberID)
+}
+
+func TestImport_Rejected(t *testing.T) {
+	owner := newOwner(t)
+	a, archive := exportAllocation(t, owner)
+
+	tests := []struct {
+		name  string
+		setup func(sa *transaction.StorageAllocation)
+		code  string
+	}{
+		{
+			name:  "not a blobber of the allocation",
+			setup: func(sa *transaction.StorageAllocation) { sa.Blobbers = sa.Blobbers[1:] },
+			code:  "invalid_blobber",
+		},
+		{
+			name:  "write marker not by the owner",
+			setup: func(sa *transaction.StorageAllocation) { sa.OwnerID = "someone_else" },
+			code:  "invalid_write_marker",
+		},
+		{
+			name:  "other allocation",
+			setup: func(sa *transaction.StorageAllocation) { sa.ID = "other_allocation" },
+			code:  "invalid_archive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := setupBlobber(t, targetBlobberID)
+			defer datastore.GetStore().Close()
+			defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+			sa := storageAllocation(a)
+			tt.setup(sa)
+			_, err := Import(ctx, sa, bytes.NewReader(archive))
+			require.Error(t, err)
+			assert.Equal(t, tt.code, err.(*common.Error).Code)
+		})
+	}
+}
+
+func TestImport_CorruptedObject(t *testing.T) {
+	a, archive := exportAllocation(t, newOwner(t))
+	corrupted := bytes.Replace(archive, []byte(fileContent),
+		[]byte(strings.ToUpper(fileContent)), 1)
+
+	ctx := setupBlobber(t, targetBlobberID)
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	_, err := Import(ctx, storageAllocation(a), bytes.NewReader(corrupted))
+	require.Error(t, err)
+	assert.Equal(t, "content_hash_mismatch", err.(*common.Error).Code)
+}
+
+func TestMigrate_AllocationRootMismatch(t *testing.T) {
+	ctx := setupBlobber(t, sourceBlobberID)
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+	a := storeAllocation(t, ctx, newOwner(t))
+
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, ImportPath+a.Tx, r.URL.Path)
+			assert.Equal(t, a.ID, r.URL.Query().Get("allocation_id"))
+			assert.Equal(t, "client", r.Header.Get(common.ClientHeader))
+			var buf bytes.Buffer
+			_, _ = buf.ReadFrom(r.Body)
+			received = buf.Len()
+			_, _ = w.Write([]byte(`{"allocation_id":"` + a.ID +
+				`","allocation_root":"other_root"}`))
+		}))
+	defer server.Close()
+
+	header := http.Header{}
+	header.Set(common.ClientHeader, "client")
+	_, err := Migrate(ctx, a, server.URL, header)
+	require.Error(t, err)
+	assert.Equal(t, "allocation_root_mismatch", err.(*common.Error).Code)
+	assert.NotZero(t, received)
+
+	// not cleaned up
+	_, err = reference.GetReference(ctx, a.ID, "/file.txt")
+	assert.NoError(t, err)
+}
+
+func TestMigrate_Rejected(t *testing.T) {
+	ctx := setupBlobber(t, sourceBlobberID)
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+	a := storeAllocation(t, ctx, newOwner(t))
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "not the owner", http.StatusForbidden)
+		}))
+	defer server.Close()
+
+	// the archive is not sent before the peer accepts it, the export fails
+	// on the pipe closed
+	header := http.Header{}
+	header.Set("Expect", "100-continue")
+	_, err := Migrate(ctx, a, server.URL, header)
+	require.Error(t, err)
+	assert.Equal(t, "migration_failed", err.(*common.Error).Code)
+	assert.Contains(t, err.Error(), "not the owner")
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
@@ -113,13 +113,13 @@ func (_m *PackageHandler) GetObjectPathGRPC(ctx context.Context, allocationID st
 	return r0, r1
 }
 
-// GetObjectTree provides a mock function with given fields: ctx, allocationID, path
-func (_m *PackageHandler) GetObjectTree(ctx context.Context, allocationID string, path string) (*reference.Ref, error) {
-	ret := _m.Called(ctx, allocationID, path)
+// GetObjectTree provides a mock function with given fields: ctx, allocationID, path, depth
+func (_m *PackageHandler) GetObjectTree(ctx context.Context, allocationID string, path string, depth int) (*reference.Ref, error) {
+	ret := _m.Called(ctx, allocationID, path, depth)
 
 	var r0 *reference.Ref
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *reference.Ref); ok {
-		r0 = rf(ctx, allocationID, path)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) *reference.Ref); ok {
+		r0 = rf(ctx, allocationID, path, depth)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*reference.Ref)
@@ -127,8 +127,8 @@ func (_m *PackageHandler) GetObjectTree(ctx context.Context, allocationID string
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, allocationID, path)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, allocationID, path, depth)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -159,6 +159,36 @@ func (_m *PackageHandler) GetRefWithChildren(ctx context.Context, allocationID s
 	return r0, r1
 }
 
+// GetRefWithChildrenPage provides a mock function with given fields: ctx, allocationID, path, page
+func (_m *PackageHandler) GetRefWithChildrenPage(ctx context.Context, allocationID string, path string, page *reference.ListPage) (*reference.Ref, string, error) {
+	ret := _m.Called(ctx, allocationID, path, page)
+
+	var r0 *reference.Ref
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *reference.ListPage) *reference.Ref); ok {
+		r0 = rf(ctx, allocationID, path, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reference.Ref)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *reference.ListPage) string); ok {
+		r1 = rf(ctx, allocationID, path, page)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, *reference.ListPage) error); ok {
+		r2 = rf(ctx, allocationID, path, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetReferenceFromLookupHash provides a mock function with given fields: ctx, allocationID, path_hash
 func (_m *PackageHandler) GetReferenceFromLookupHash(ctx context.Context, allocationID string, path_hash string) (*reference.Ref, error) {
 	ret := _m.Called(ctx, allocationID, path_hash)
@@ -182,13 +212,13 @@ func (_m *PackageHandler) GetReferenceFromLookupHash(ctx context.Context, alloca
 	return r0, r1
 }
 
-// GetReferencePathFromPaths provides a mock function with given fields: ctx, allocationID, paths
-func (_m *PackageHandler) GetReferencePathFromPaths(ctx context.Context, allocationID string, paths []string) (*reference.Ref, error) {
-	ret := _m.Called(ctx, allocationID, paths)
+// GetReferencePathFromPaths provides a mock function with given fields: ctx, allocationID, paths, depth
+func (_m *PackageHandler) GetReferencePathFromPaths(ctx context.Context, allocationID string, paths []string, depth int) (*reference.Ref, error) {
+	ret := _m.Called(ctx, allocationID, paths, depth)
 
 	var r0 *reference.Ref
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) *reference.Ref); ok {
-		r0 = rf(ctx, allocationID, paths)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int) *reference.Ref); ok {
+		r0 = rf(ctx, allocationID, paths, depth)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*reference.Ref)
@@ -196,8 +226,8 @@ func (_m *PackageHandler) GetReferencePathFromPaths(ctx context.Context, allocat
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
-		r1 = rf(ctx, allocationID, paths)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, int) error); ok {
+		r1 = rf(ctx, allocationID, paths, depth)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -241,3 +271,33 @@ func (_m *PackageHandler) IsACollaborator(ctx context.Context, refID int64, clie
 
 	return r0
 }
+
+// SearchRefs provides a mock function with given fields: ctx, allocationID, filter
+func (_m *PackageHandler) SearchRefs(ctx context.Context, allocationID string, filter *reference.SearchFilter) ([]*reference.Ref, int64, error) {
+	ret := _m.Called(ctx, allocationID, filter)
+
+	var r0 []*reference.Ref
+	if rf, ok := ret.Get(0).(func(context.Context, string, *reference.SearchFilter) []*reference.Ref); ok {
+		r0 = rf(ctx, allocationID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*reference.Ref)
+		}
+	}
+
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(context.Context, string, *reference.SearchFilter) int64); ok {
+		r1 = rf(ctx, allocationID, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *reference.SearchFilter) error); ok {
+		r2 = rf(ctx, allocationID, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
@@ -191,6 +191,7 @@ func (rm *ReadMarkerEntity) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return common.NewError("rme_update_status", err.Error())
 	}
+	rm.LastRedeemTxnID = redeemTxn
 
 	// update cache using the transaction output
 	allocation.SubReadRedeemed(rps, redeems)
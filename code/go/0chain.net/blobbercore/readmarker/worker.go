@@ -7,8 +7,11 @@ import (
 
 	"0chain.net/blobbercore/config"
 	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/metrics"
+	"0chain.net/blobbercore/webhook"
 	"0chain.net/core/chain"
 	. "0chain.net/core/logging"
+	coremetrics "0chain.net/core/metrics"
 	"0chain.net/core/transaction"
 
 	"github.com/remeh/sizedwaitgroup"
@@ -66,7 +69,10 @@ func RedeemReadMarker(ctx context.Context, rmEntity *ReadMarkerEntity) (
 
 	// so, now the latestRM.ReadCounter is less than rmEntity.LatestRM.ReadCounter
 
-	if err = rmEntity.RedeemReadMarker(ctx); err != nil {
+	err = rmEntity.RedeemReadMarker(ctx)
+	metrics.Redeemed(metrics.ReadMarkerRedeems, err)
+	notifyRedeem(ctx, rmEntity, err)
+	if err != nil {
 		Logger.Error("error redeeming the read marker.",
 			zap.Any("rm", rmEntity), zap.Error(err))
 		return
@@ -77,6 +83,26 @@ func RedeemReadMarker(ctx context.Context, rmEntity *ReadMarkerEntity) (
 	return
 }
 
+// notifyRedeem notifies the webhooks of the outcome of the redeeming.
+func notifyRedeem(ctx context.Context, rme *ReadMarkerEntity, redeemErr error) {
+	var (
+		event = webhook.EventReadMarkerRedeemed
+		data  = &webhook.MarkerData{
+			AllocationID: rme.LatestRM.AllocationID,
+			ClientID:     rme.LatestRM.ClientID,
+			ReadCounter:  rme.LatestRM.ReadCounter,
+		}
+	)
+	if redeemErr != nil {
+		event, data.Error = webhook.EventReadMarkerFailed, redeemErr.Error()
+	} else {
+		data.TxnHash = rme.LastRedeemTxnID
+	}
+	if err := webhook.Notify(ctx, event, data); err != nil {
+		Logger.Error("Notifying the read marker redeem", zap.Error(err))
+	}
+}
+
 var iterInprogress = false
 
 func RedeemMarkers(ctx context.Context) {
@@ -88,6 +114,7 @@ func RedeemMarkers(ctx context.Context) {
 		case <-ticker.C:
 			if !iterInprogress {
 				iterInprogress = true
+				start := time.Now()
 				rctx := datastore.GetStore().CreateTransaction(ctx)
 				db := datastore.GetStore().GetTransaction(rctx)
 				readMarkers := make([]*ReadMarkerEntity, 0)
@@ -106,8 +133,8 @@ func RedeemMarkers(ctx context.Context) {
 							if err != nil {
 								Logger.Error("Error redeeming the read marker.", zap.Error(err))
 							}
-							db := datastore.GetStore().GetTransaction(redeemCtx)
-							err = db.Commit().Error
+							// through the store, its hooks wake the webhooks worker up
+							err = datastore.GetStore().Commit(redeemCtx)
 							if err != nil {
 								Logger.Error("Error commiting the readmarker redeem", zap.Error(err))
 							}
@@ -118,6 +145,7 @@ func RedeemMarkers(ctx context.Context) {
 				}
 				db.Rollback()
 				rctx.Done()
+				coremetrics.ObserveWorkerLoop("readmarker_redeem", start)
 				iterInprogress = false
 			}
 		}
@@ -0,0 +1,138 @@
+package reference
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultPageLimit is the number of references of a search or listing
+	// page when the request does not set it.
+	DefaultPageLimit = 100
+	// MaxPageLimit is the max number of references of a page.
+	MaxPageLimit = 1000
+)
+
+// The orders of the children of a listing page.
+const (
+	SortByName      = "name"
+	SortByUpdatedAt = "updated_at"
+)
+
+func pageLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultPageLimit
+	}
+	if limit > MaxPageLimit {
+		return MaxPageLimit
+	}
+	return limit
+}
+
+// ListPage selects a page of the children of a directory.
+type ListPage struct {
+	// SortBy is SortByName, the default, or SortByUpdatedAt.
+	SortBy string
+	// Cursor is the one returned with the previous page, empty for the
+	// first page.
+	Cursor string
+	Limit  int
+}
+
+// listCursor is the position of the last child of a page in its order.
+type listCursor struct {
+	Name      string `json:"n,omitempty"`
+	UpdatedAt string `json:"u,omitempty"`
+	ID        int64  `json:"i"`
+}
+
+func encodeListCursor(sortBy string, ref *Ref) (string, error) {
+	c := listCursor{ID: ref.ID}
+	if sortBy == SortByUpdatedAt {
+		data, err := ref.UpdatedAt.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		c.UpdatedAt = string(data)
+	} else {
+		c.Name = ref.Name
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// after restricts the query to the children past the cursor.
+func (p *ListPage) after(db *gorm.DB) (*gorm.DB, error) {
+	if p.Cursor == "" {
+		return db, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(p.Cursor)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid cursor")
+	}
+	var c listCursor
+	if err = json.Unmarshal(data, &c); err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid cursor")
+	}
+	if p.SortBy == SortByUpdatedAt {
+		var updatedAt time.Time
+		if err = updatedAt.UnmarshalText([]byte(c.UpdatedAt)); err != nil {
+			return nil, common.NewError("invalid_parameters", "Invalid cursor")
+		}
+		return db.Where("updated_at > ? OR (updated_at = ? AND id > ?)",
+			updatedAt, updatedAt, c.ID), nil
+	}
+	return db.Where("name > ?", c.Name), nil
+}
+
+// GetRefWithChildrenPage returns the directory of the path with a page of its
+// children, and the cursor of the next page, empty for the last.
+func GetRefWithChildrenPage(ctx context.Context, allocationID string, path string, page *ListPage) (*Ref, string, error) {
+	if page.SortBy != "" && page.SortBy != SortByName && page.SortBy != SortByUpdatedAt {
+		return nil, "", common.NewError("invalid_parameters", "Invalid sort order")
+	}
+	db := datastore.GetStore().GetTransaction(ctx)
+	dirRef := new(Ref)
+	err := db.Where(Ref{Type: DIRECTORY, Path: path, AllocationID: allocationID}).First(dirRef).Error
+	if err == gorm.ErrRecordNotFound {
+		return &Ref{Type: DIRECTORY, Path: path, AllocationID: allocationID}, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	query, err := page.after(db.Where(Ref{ParentPath: path, AllocationID: allocationID}))
+	if err != nil {
+		return nil, "", err
+	}
+	if page.SortBy == SortByUpdatedAt {
+		query = query.Order("updated_at, id")
+	} else {
+		query = query.Order("name")
+	}
+	limit := pageLimit(page.Limit)
+	var children []*Ref
+	if err = query.Limit(limit + 1).Find(&children).Error; err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(children) > limit {
+		children = children[:limit]
+		if next, err = encodeListCursor(page.SortBy, children[limit-1]); err != nil {
+			return nil, "", err
+		}
+	}
+	dirRef.Children = children
+	return dirRef, next, nil
+}
@@ -0,0 +1,104 @@
+package reference
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func listPages(t *testing.T, page *ListPage) [][]string {
+	datastore.OpenTheSQLiteStore(t)
+	defer datastore.GetStore().Close()
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	// the children updated in the reverse order of their names, but for the
+	// two last ones updated at once
+	updatedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, ref := range []*Ref{
+		{Type: DIRECTORY, Path: "/"},
+		{Type: DIRECTORY, Path: "/dir"},
+		{Type: FILE, Path: "/dir/e"},
+		{Type: FILE, Path: "/dir/d"},
+		{Type: FILE, Path: "/dir/c"},
+		{Type: FILE, Path: "/dir/b"},
+		{Type: FILE, Path: "/dir/a"},
+	} {
+		ref.AllocationID = searchAllocationID
+		ref.Name = filepath.Base(ref.Path)
+		ref.LookupHash = GetReferenceLookup(searchAllocationID, ref.Path)
+		if ref.Path != "/" {
+			ref.ParentPath = filepath.Dir(ref.Path)
+		}
+		if i < 6 {
+			updatedAt = updatedAt.Add(time.Second)
+		}
+		ref.UpdatedAt = updatedAt
+		require.NoError(t, datastore.GetStore().GetTransaction(ctx).Create(ref).Error)
+	}
+
+	var pages [][]string
+	for {
+		dirRef, next, err := GetRefWithChildrenPage(ctx, searchAllocationID, "/dir", page)
+		require.NoError(t, err)
+		assert.Equal(t, "/dir", dirRef.Path)
+		var names []string
+		for _, child := range dirRef.Children {
+			names = append(names, child.Name)
+		}
+		pages = append(pages, names)
+		if next == "" {
+			return pages
+		}
+		page.Cursor = next
+	}
+}
+
+func TestGetRefWithChildrenPage(t *testing.T) {
+	assert.Equal(t, [][]string{{"a", "b", "c"}, {"d", "e"}},
+		listPages(t, &ListPage{Limit: 3}))
+	assert.Equal(t, [][]string{{"e", "d"}, {"c", "b"}, {"a"}},
+		listPages(t, &ListPage{SortBy: SortByUpdatedAt, Limit: 2}))
+}
+
+func TestGetRefWithChildrenPage_Invalid(t *testing.T) {
+	ctx := storeSearchTree(t)
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	_, _, err := GetRefWithChildrenPage(ctx, searchAllocationID, "/photos", &ListPage{SortBy: "size"})
+	require.Error(t, err)
+	_, _, err = GetRefWithChildrenPage(ctx, searchAllocationID, "/photos", &ListPage{Cursor: "?"})
+	require.Error(t, err)
+}
+
+func TestGetObjectTreeDepth(t *testing.T) {
+	ctx := storeSearchTree(t)
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	tree, err := GetObjectTreeDepth(ctx, searchAllocationID, "/photos", 1)
+	require.NoError(t, err)
+	require.Len(t, tree.Children, 4)
+	for _, child := range tree.Children {
+		assert.Empty(t, child.Children, child.Path)
+	}
+
+	tree, err = GetObjectTreeDepth(ctx, searchAllocationID, "/photos", 0)
+	require.NoError(t, err)
+	require.Len(t, tree.Children, 4)
+	assert.Len(t, tree.Children[3].Children, 1)
+
+	rootRef, err := GetReferencePathFromPathsDepth(ctx, searchAllocationID, []string{"/photos/sub/c.jpg"}, 1)
+	require.NoError(t, err)
+	assert.Len(t, rootRef.Children, 3)
+	for _, child := range rootRef.Children {
+		assert.Empty(t, child.Children, child.Path)
+	}
+}
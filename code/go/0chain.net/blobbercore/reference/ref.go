@@ -89,6 +89,10 @@ type Ref struct {
 	CreatedAt      time.Time       `gorm:"column:created_at" dirlist:"created_at" filelist:"created_at"`
 	UpdatedAt      time.Time       `gorm:"column:updated_at" dirlist:"updated_at" filelist:"updated_at"`
 
+	// LastVersion is the number of the latest version of the file, kept
+	// by AddFileVersion only.
+	LastVersion int64 `gorm:"column:last_version;->"`
+
 	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at"` // soft deletion
 }
 
@@ -251,7 +255,9 @@ func (fr *Ref) CalculateFileHash(ctx context.Context, saveToDB bool) (string, er
 }
 
 func (r *Ref) CalculateDirHash(ctx context.Context, saveToDB bool) (string, error) {
-	if len(r.Children) == 0 && !r.childrenLoaded {
+	// the children of a stored directory may just not be loaded, a new one
+	// is empty
+	if len(r.Children) == 0 && !r.childrenLoaded && r.ID > 0 {
 		return r.Hash, nil
 	}
 	sort.SliceStable(r.Children, func(i, j int) bool {
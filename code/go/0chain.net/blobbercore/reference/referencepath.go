@@ -6,6 +6,8 @@ import (
 
 	"0chain.net/blobbercore/datastore"
 	"0chain.net/core/common"
+
+	"gorm.io/gorm"
 )
 
 func GetReferencePath(ctx context.Context, allocationID string, path string) (*Ref, error) {
@@ -13,12 +15,22 @@ func GetReferencePath(ctx context.Context, allocationID string, path string) (*R
 }
 
 func GetReferencePathFromPaths(ctx context.Context, allocationID string, paths []string) (*Ref, error) {
+	return GetReferencePathFromPathsDepth(ctx, allocationID, paths, 0)
+}
+
+// GetReferencePathFromPathsDepth returns the reference path of the paths cut
+// at depth levels below the root, unless depth is zero.
+func GetReferencePathFromPathsDepth(ctx context.Context, allocationID string, paths []string, depth int) (*Ref, error) {
 	var refs []Ref
 	db := datastore.GetStore().GetTransaction(ctx)
 	pathsAdded := make(map[string]bool)
 	for _, path := range paths {
 		if _, ok := pathsAdded[path]; !ok {
-			db = db.Where(Ref{ParentPath: path, AllocationID: allocationID})
+			if len(pathsAdded) == 0 {
+				db = db.Where(Ref{ParentPath: path, AllocationID: allocationID})
+			} else {
+				db = db.Or(Ref{ParentPath: path, AllocationID: allocationID})
+			}
 			pathsAdded[path] = true
 		}
 		depth := len(GetSubDirsFromPath(path)) + 1
@@ -33,6 +45,7 @@ func GetReferencePathFromPaths(ctx context.Context, allocationID string, paths [
 	}
 
 	db = db.Or("parent_path = ? AND allocation_id = ?", "", allocationID)
+	db = limitDepth(ctx, db, "/", depth)
 	err := db.Order("level, lookup_hash").Find(&refs).Error
 	if err != nil {
 		return nil, err
@@ -65,6 +78,12 @@ func GetReferencePathFromPaths(ctx context.Context, allocationID string, paths [
 }
 
 func GetObjectTree(ctx context.Context, allocationID string, path string) (*Ref, error) {
+	return GetObjectTreeDepth(ctx, allocationID, path, 0)
+}
+
+// GetObjectTreeDepth returns the tree of the path down to depth levels below
+// it, unless depth is zero.
+func GetObjectTreeDepth(ctx context.Context, allocationID string, path string, depth int) (*Ref, error) {
 	path = filepath.Clean(path)
 	var refs []Ref
 	db := datastore.GetStore().GetTransaction(ctx)
@@ -74,6 +93,7 @@ func GetObjectTree(ctx context.Context, allocationID string, path string) (*Ref,
 	} else {
 		db = db.Or("path LIKE ? AND allocation_id = ?", (path + "%"), allocationID)
 	}
+	db = limitDepth(ctx, db, path, depth)
 
 	err := db.Order("level, lookup_hash").Find(&refs).Error
 	if err != nil {
@@ -91,5 +111,21 @@ func GetObjectTree(ctx context.Context, allocationID string, path string) (*Ref,
 		childMap[refs[i].ParentPath].AddChild(&refs[i])
 		childMap[refs[i].Path] = &refs[i]
 	}
+	// the whole tree is loaded, empty directories included, but the
+	// children of the deepest level
+	maxLevel := len(GetSubDirsFromPath(path)) + 1 + depth
+	for i := range refs {
+		refs[i].childrenLoaded = depth <= 0 || refs[i].PathLevel < maxLevel
+	}
 	return &refs[0], nil
 }
+
+// limitDepth restricts the query to the references at most depth levels
+// below the path, unless depth is zero.
+func limitDepth(ctx context.Context, db *gorm.DB, path string, depth int) *gorm.DB {
+	if depth <= 0 {
+		return db
+	}
+	level := len(GetSubDirsFromPath(path)) + 1 + depth
+	return datastore.GetStore().GetTransaction(ctx).Where(db).Where("level <= ?", level)
+}
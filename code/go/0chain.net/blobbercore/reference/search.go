@@ -0,0 +1,108 @@
+package reference
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+)
+
+// SearchFilter selects the references of a search. The zero values of the
+// fields do not filter.
+type SearchFilter struct {
+	// Path is the directory searched, with all its descendants.
+	Path string
+	// Name is a glob of the names, where '*' matches any characters and '?'
+	// a single one.
+	Name string
+	// MimeType is the mime type of the files, or its prefix followed by '*'
+	// as in "image/*".
+	MimeType string
+	// Type is FILE or DIRECTORY.
+	Type string
+	// MinSize and MaxSize bound the sizes, inclusive.
+	MinSize int64
+	MaxSize int64
+	// CreatedAfter, CreatedBefore, UpdatedAfter and UpdatedBefore bound the
+	// creation and update times, inclusive.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+	// CustomMeta is contained in the custom meta of the files.
+	CustomMeta string
+	// After is the id of the last reference of the previous page.
+	After int64
+	// Limit is the number of references of the page.
+	Limit int
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern, with '\' as the
+// escape character.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// globToLike converts a glob to a LIKE pattern.
+func globToLike(glob string) string {
+	return strings.NewReplacer("*", "%", "?", "_").Replace(escapeLike(glob))
+}
+
+// SearchRefs returns a page of the references of the allocation matching the
+// filter, ordered by id, and the cursor of the next page, zero for the last.
+func SearchRefs(ctx context.Context, allocationID string, filter *SearchFilter) ([]*Ref, int64, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	db = db.Where("allocation_id = ? AND id > ?", allocationID, filter.After)
+
+	if filter.Path != "" && filter.Path != "/" {
+		db = db.Where(`path LIKE ? ESCAPE '\'`, escapeLike(filter.Path)+"/%")
+	} else {
+		db = db.Where("path != ?", "/")
+	}
+	if filter.Name != "" {
+		db = db.Where(`name LIKE ? ESCAPE '\'`, globToLike(filter.Name))
+	}
+	if strings.HasSuffix(filter.MimeType, "*") {
+		db = db.Where(`mimetype LIKE ? ESCAPE '\'`,
+			escapeLike(strings.TrimSuffix(filter.MimeType, "*"))+"%")
+	} else if filter.MimeType != "" {
+		db = db.Where("mimetype = ?", filter.MimeType)
+	}
+	if filter.Type != "" {
+		db = db.Where("type = ?", filter.Type)
+	}
+	if filter.MinSize > 0 {
+		db = db.Where("size >= ?", filter.MinSize)
+	}
+	if filter.MaxSize > 0 {
+		db = db.Where("size <= ?", filter.MaxSize)
+	}
+	if !filter.CreatedAfter.IsZero() {
+		db = db.Where("created_at >= ?", filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		db = db.Where("created_at <= ?", filter.CreatedBefore)
+	}
+	if !filter.UpdatedAfter.IsZero() {
+		db = db.Where("updated_at >= ?", filter.UpdatedAfter)
+	}
+	if !filter.UpdatedBefore.IsZero() {
+		db = db.Where("updated_at <= ?", filter.UpdatedBefore)
+	}
+	if filter.CustomMeta != "" {
+		db = db.Where(`custom_meta LIKE ? ESCAPE '\'`, "%"+escapeLike(filter.CustomMeta)+"%")
+	}
+
+	limit := pageLimit(filter.Limit)
+	var refs []*Ref
+	err := db.Order("id").Limit(limit + 1).Find(&refs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(refs) <= limit {
+		return refs, 0, nil
+	}
+	refs = refs[:limit]
+	return refs, refs[limit-1].ID, nil
+}
@@ -0,0 +1,109 @@
+package reference
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const searchAllocationID = "search_alloc"
+
+func storeSearchTree(t *testing.T) context.Context {
+	datastore.OpenTheSQLiteStore(t)
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	db := datastore.GetStore().GetTransaction(ctx)
+
+	for _, ref := range []*Ref{
+		{Type: DIRECTORY, Path: "/"},
+		{Type: DIRECTORY, Path: "/photos"},
+		{Type: FILE, Path: "/photos/a.jpg", MimeType: "image/jpeg", Size: 100, CustomMeta: `{"tag":"cat"}`},
+		{Type: FILE, Path: "/photos/b_1.png", MimeType: "image/png", Size: 5},
+		{Type: FILE, Path: "/photos/bx1.png", MimeType: "image/png", Size: 5},
+		{Type: DIRECTORY, Path: "/photos/sub"},
+		{Type: FILE, Path: "/photos/sub/c.jpg", MimeType: "image/jpeg", Size: 300},
+		{Type: DIRECTORY, Path: "/photos_old"},
+		{Type: FILE, Path: "/photos_old/d.jpg", MimeType: "image/jpeg", Size: 100},
+		{Type: FILE, Path: "/doc.txt", MimeType: "text/plain", Size: 100},
+	} {
+		ref.AllocationID = searchAllocationID
+		ref.Name = filepath.Base(ref.Path)
+		ref.LookupHash = GetReferenceLookup(searchAllocationID, ref.Path)
+		ref.PathLevel = len(GetSubDirsFromPath(ref.Path)) + 1
+		if ref.Path != "/" {
+			ref.ParentPath = filepath.Dir(ref.Path)
+		}
+		require.NoError(t, db.Create(ref).Error)
+	}
+	return ctx
+}
+
+func searchPaths(t *testing.T, ctx context.Context, filter *SearchFilter) []string {
+	refs, _, err := SearchRefs(ctx, searchAllocationID, filter)
+	require.NoError(t, err)
+	var paths []string
+	for _, ref := range refs {
+		paths = append(paths, ref.Path)
+	}
+	return paths
+}
+
+func TestSearchRefs(t *testing.T) {
+	ctx := storeSearchTree(t)
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	tests := []struct {
+		name   string
+		filter *SearchFilter
+		want   []string
+	}{
+		{"glob under directory", &SearchFilter{Path: "/photos", Name: "*.jpg"},
+			[]string{"/photos/a.jpg", "/photos/sub/c.jpg"}},
+		{"literal underscore", &SearchFilter{Path: "/photos", Name: "b_1.png"},
+			[]string{"/photos/b_1.png"}},
+		{"single character", &SearchFilter{Path: "/photos", Name: "b?1.png"},
+			[]string{"/photos/b_1.png", "/photos/bx1.png"}},
+		{"mime type prefix and size", &SearchFilter{MimeType: "image/*", MinSize: 50, MaxSize: 200},
+			[]string{"/photos/a.jpg", "/photos_old/d.jpg"}},
+		{"mime type", &SearchFilter{MimeType: "text/plain"},
+			[]string{"/doc.txt"}},
+		{"custom meta", &SearchFilter{CustomMeta: `"tag":"cat"`},
+			[]string{"/photos/a.jpg"}},
+		{"directories", &SearchFilter{Type: DIRECTORY},
+			[]string{"/photos", "/photos/sub", "/photos_old"}},
+		{"created in range", &SearchFilter{Path: "/photos/sub",
+			CreatedAfter: time.Now().Add(-time.Hour), CreatedBefore: time.Now().Add(time.Hour)},
+			[]string{"/photos/sub/c.jpg"}},
+		{"updated later", &SearchFilter{UpdatedAfter: time.Now().Add(time.Hour)}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, searchPaths(t, ctx, tt.filter))
+		})
+	}
+}
+
+func TestSearchRefs_Pages(t *testing.T) {
+	ctx := storeSearchTree(t)
+	defer datastore.GetStore().Close()
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	filter := &SearchFilter{Name: "*.jpg", Limit: 2}
+	refs, next, err := SearchRefs(ctx, searchAllocationID, filter)
+	require.NoError(t, err)
+	require.Len(t, refs, 2)
+	assert.Equal(t, refs[1].ID, next)
+
+	filter.After = next
+	refs, next, err = SearchRefs(ctx, searchAllocationID, filter)
+	require.NoError(t, err)
+	require.Len(t, refs, 1)
+	assert.Equal(t, "/photos_old/d.jpg", refs[0].Path)
+	assert.Zero(t, next)
+}
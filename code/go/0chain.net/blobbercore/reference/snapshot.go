@@ -0,0 +1,197 @@
+package reference
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+
+	"gorm.io/gorm"
+)
+
+// SnapshotRef is a reference of the tree of an allocation as of one of its
+// allocation roots, kept to roll the allocation back to that root.
+type SnapshotRef struct {
+	ID             int64     `gorm:"column:id;primary_key"`
+	AllocationID   string    `gorm:"column:allocation_id"`
+	AllocationRoot string    `gorm:"column:allocation_root"`
+	ContentHash    string    `gorm:"column:content_hash"`
+	ThumbnailHash  string    `gorm:"column:thumbnail_hash"`
+	Ref            string    `gorm:"column:ref"`
+	CreatedAt      time.Time `gorm:"column:created_at"`
+}
+
+func (SnapshotRef) TableName() string {
+	return "reference_snapshots"
+}
+
+// SaveSnapshot keeps the reference tree of the allocation as of the
+// allocation root, and drops the oldest snapshots beyond the keep latest.
+func SaveSnapshot(ctx context.Context, allocationID, allocationRoot string, keep int) error {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var refs []*Ref
+	err := db.Where(&Ref{AllocationID: allocationID}).Find(&refs).Error
+	if err != nil {
+		return err
+	}
+	for _, ref := range refs {
+		data, err := json.Marshal(ref)
+		if err != nil {
+			return common.NewError("snapshot_error", err.Error())
+		}
+		err = db.Create(&SnapshotRef{
+			AllocationID:   allocationID,
+			AllocationRoot: allocationRoot,
+			ContentHash:    ref.ContentHash,
+			ThumbnailHash:  ref.ThumbnailHash,
+			Ref:            string(data),
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return pruneSnapshots(ctx, allocationID, keep)
+}
+
+// snapshotRoots returns the allocation roots of the snapshots of the
+// allocation, latest first.
+func snapshotRoots(ctx context.Context, allocationID string) ([]string, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	rows, err := db.Model(&SnapshotRef{}).Select("allocation_root").
+		Where("allocation_id = ?", allocationID).
+		Group("allocation_root").Order("MAX(id) DESC").Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var roots []string
+	for rows.Next() {
+		var root string
+		if err := rows.Scan(&root); err != nil {
+			return nil, err
+		}
+		roots = append(roots, root)
+	}
+	return roots, rows.Err()
+}
+
+func pruneSnapshots(ctx context.Context, allocationID string, keep int) error {
+	roots, err := snapshotRoots(ctx, allocationID)
+	if err != nil || len(roots) <= keep {
+		return err
+	}
+	return deleteSnapshots(ctx, allocationID, roots[keep:])
+}
+
+func deleteSnapshots(ctx context.Context, allocationID string, roots []string) error {
+	db := datastore.GetStore().GetTransaction(ctx)
+	return db.Where("allocation_id = ? AND allocation_root IN ?", allocationID, roots).
+		Delete(&SnapshotRef{}).Error
+}
+
+// DeleteSnapshotsAfter drops the snapshots of the allocation taken after the
+// one of the allocation root.
+func DeleteSnapshotsAfter(ctx context.Context, allocationID, allocationRoot string) error {
+	roots, err := snapshotRoots(ctx, allocationID)
+	if err != nil {
+		return err
+	}
+	for i, root := range roots {
+		if root == allocationRoot {
+			if i == 0 {
+				return nil
+			}
+			return deleteSnapshots(ctx, allocationID, roots[:i])
+		}
+	}
+	return common.NewError("snapshot_not_found",
+		"No snapshot of the allocation root "+allocationRoot)
+}
+
+// GetSnapshot returns the references of the allocation as of the allocation
+// root.
+func GetSnapshot(ctx context.Context, allocationID, allocationRoot string) ([]*Ref, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var rows []*SnapshotRef
+	err := db.Where(&SnapshotRef{AllocationID: allocationID,
+		AllocationRoot: allocationRoot}).Order("id").Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, common.NewError("snapshot_not_found",
+			"No snapshot of the allocation root "+allocationRoot)
+	}
+	refs := make([]*Ref, 0, len(rows))
+	for _, row := range rows {
+		ref := new(Ref)
+		if err := json.Unmarshal([]byte(row.Ref), ref); err != nil {
+			return nil, common.NewError("snapshot_error", err.Error())
+		}
+		// JSON turns the unset columns into a zero time and a null
+		ref.DeletedAt = gorm.DeletedAt{}
+		if string(ref.Attributes) == "null" {
+			ref.Attributes = nil
+		}
+		ref.CommitMetaTxns = nil
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+// RestoreSnapshot replaces the references of the allocation with the ones of
+// the snapshot, and returns the references deleted or replaced by another
+// content.
+func RestoreSnapshot(ctx context.Context, allocationID string, snapshot []*Ref) ([]*Ref, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var current []*Ref
+	err := db.Where(&Ref{AllocationID: allocationID}).Find(&current).Error
+	if err != nil {
+		return nil, err
+	}
+
+	restored := make(map[int64]*Ref, len(snapshot))
+	for _, ref := range snapshot {
+		restored[ref.ID] = ref
+	}
+	var (
+		replaced []*Ref
+		deleted  []int64
+	)
+	for _, ref := range current {
+		r, ok := restored[ref.ID]
+		if !ok {
+			deleted = append(deleted, ref.ID)
+		}
+		if !ok || r.ContentHash != ref.ContentHash || r.ThumbnailHash != ref.ThumbnailHash {
+			replaced = append(replaced, ref)
+		}
+	}
+	// for good, these are not files deleted to the trash
+	if len(deleted) > 0 {
+		if err := deleteRefs(db, deleted); err != nil {
+			return nil, err
+		}
+	}
+	for _, ref := range snapshot {
+		// brings the deleted references back as well
+		if err := db.Unscoped().Save(ref).Error; err != nil {
+			return nil, err
+		}
+	}
+	return replaced, nil
+}
+
+// IsSnapshotObject tells whether a snapshot of the allocation refers to the
+// content or the thumbnail object of the hash.
+func IsSnapshotObject(ctx context.Context, allocationID, hash string) (bool, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var count int64
+	err := db.Model(&SnapshotRef{}).
+		Where("allocation_id = ? AND (content_hash = ? OR thumbnail_hash = ?)",
+			allocationID, hash, hash).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,88 @@
+package reference
+
+import (
+	"context"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/stats"
+
+	"gorm.io/gorm"
+)
+
+// GetTrash returns the files of the allocation deleted after the time, the
+// latest deleted first.
+func GetTrash(ctx context.Context, allocationID string, since time.Time) ([]*Ref, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var refs []*Ref
+	err := db.Unscoped().
+		Where("allocation_id = ? AND type = ? AND deleted_at > ?", allocationID, FILE, since).
+		Order("deleted_at DESC").Find(&refs).Error
+	return refs, err
+}
+
+// GetTrashedRef returns the file of the allocation deleted after the time.
+func GetTrashedRef(ctx context.Context, allocationID string, refID int64, since time.Time) (*Ref, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	ref := new(Ref)
+	err := db.Unscoped().
+		Where("id = ? AND allocation_id = ? AND type = ? AND deleted_at > ?",
+			refID, allocationID, FILE, since).
+		First(ref).Error
+	if err != nil {
+		return nil, err
+	}
+	return ref, nil
+}
+
+// UndeleteReference brings a deleted reference back.
+func UndeleteReference(ctx context.Context, refID int64) error {
+	db := datastore.GetStore().GetTransaction(ctx)
+	return db.Unscoped().Model(&Ref{}).Where("id = ?", refID).
+		Update("deleted_at", nil).Error
+}
+
+// IsTrashObject tells whether a file of the allocation deleted after the
+// time refers to the content or the thumbnail object of the hash.
+func IsTrashObject(ctx context.Context, allocationID, hash string, since time.Time) (bool, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var count int64
+	err := db.Unscoped().Model(&Ref{}).
+		Where("allocation_id = ? AND type = ? AND deleted_at > ? AND (content_hash = ? OR thumbnail_hash = ?)",
+			allocationID, FILE, since, hash, hash).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// PurgeTrash deletes for good the references of the allocation deleted
+// before the time, with their stats, collaborators and commit meta
+// transactions, and returns them.
+func PurgeTrash(ctx context.Context, allocationID string, before time.Time) ([]*Ref, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var refs []*Ref
+	err := db.Unscoped().
+		Where("allocation_id = ? AND deleted_at IS NOT NULL AND deleted_at < ?", allocationID, before).
+		Find(&refs).Error
+	if err != nil || len(refs) == 0 {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(refs))
+	for _, ref := range refs {
+		ids = append(ids, ref.ID)
+	}
+	if err = deleteRefs(db, ids); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// deleteRefs deletes for good the references, with their stats,
+// collaborators and commit meta transactions.
+func deleteRefs(db *gorm.DB, ids []int64) error {
+	for _, model := range []interface{}{&stats.FileStats{}, &Collaborator{}, &CommitMetaTxn{}} {
+		if err := db.Where("ref_id IN ?", ids).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+	return db.Unscoped().Where("id IN ?", ids).Delete(&Ref{}).Error
+}
@@ -0,0 +1,189 @@
+package reference
+
+import (
+	"context"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+
+	"gorm.io/gorm"
+)
+
+// FileVersion is a previous content of a file, replaced by an update. The
+// versions of a file are numbered from 1, the numbers of the pruned ones
+// never reused, and follow its reference when renamed or moved.
+type FileVersion struct {
+	ID                  int64     `gorm:"column:id;primary_key" json:"-"`
+	AllocationID        string    `gorm:"column:allocation_id" json:"-"`
+	RefID               int64     `gorm:"column:ref_id" json:"-"`
+	Version             int64     `gorm:"column:version" json:"version"`
+	ContentHash         string    `gorm:"column:content_hash" json:"content_hash"`
+	MerkleRoot          string    `gorm:"column:merkle_root" json:"merkle_root"`
+	Size                int64     `gorm:"column:size" json:"size"`
+	ActualFileHash      string    `gorm:"column:actual_file_hash" json:"actual_file_hash"`
+	ActualFileSize      int64     `gorm:"column:actual_file_size" json:"actual_file_size"`
+	ThumbnailHash       string    `gorm:"column:thumbnail_hash" json:"thumbnail_hash"`
+	ThumbnailSize       int64     `gorm:"column:thumbnail_size" json:"thumbnail_size"`
+	ActualThumbnailHash string    `gorm:"column:actual_thumbnail_hash" json:"actual_thumbnail_hash"`
+	ActualThumbnailSize int64     `gorm:"column:actual_thumbnail_size" json:"actual_thumbnail_size"`
+	MimeType            string    `gorm:"column:mimetype" json:"mimetype"`
+	EncryptedKey        string    `gorm:"column:encrypted_key" json:"encrypted_key"`
+	WriteMarker         string    `gorm:"column:write_marker" json:"write_marker"`
+	CreatedAt           time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (FileVersion) TableName() string {
+	return "file_versions"
+}
+
+// AddFileVersion keeps the current content of the file as its latest
+// previous version.
+func AddFileVersion(ctx context.Context, ref *Ref) (*FileVersion, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	err := db.Table(ref.TableName()).Where("id = ?", ref.ID).
+		UpdateColumn("last_version", gorm.Expr("last_version + 1")).Error
+	if err != nil {
+		return nil, err
+	}
+	err = db.Table(ref.TableName()).Select("last_version").
+		Where("id = ?", ref.ID).Row().Scan(&ref.LastVersion)
+	if err != nil {
+		return nil, err
+	}
+	version := &FileVersion{
+		AllocationID:        ref.AllocationID,
+		RefID:               ref.ID,
+		Version:             ref.LastVersion,
+		ContentHash:         ref.ContentHash,
+		MerkleRoot:          ref.MerkleRoot,
+		Size:                ref.Size,
+		ActualFileHash:      ref.ActualFileHash,
+		ActualFileSize:      ref.ActualFileSize,
+		ThumbnailHash:       ref.ThumbnailHash,
+		ThumbnailSize:       ref.ThumbnailSize,
+		ActualThumbnailHash: ref.ActualThumbnailHash,
+		ActualThumbnailSize: ref.ActualThumbnailSize,
+		MimeType:            ref.MimeType,
+		EncryptedKey:        ref.EncryptedKey,
+		WriteMarker:         ref.WriteMarker,
+	}
+	if err = db.Create(version).Error; err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+// GetFileVersions returns the previous versions of the file, latest first.
+func GetFileVersions(ctx context.Context, refID int64) ([]*FileVersion, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var versions []*FileVersion
+	err := db.Where(&FileVersion{RefID: refID}).
+		Order("version DESC").Find(&versions).Error
+	return versions, err
+}
+
+// GetFileVersion returns a previous version of the file.
+func GetFileVersion(ctx context.Context, refID, version int64) (*FileVersion, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	fv := new(FileVersion)
+	err := db.Where(&FileVersion{RefID: refID, Version: version}).First(fv).Error
+	if err != nil {
+		return nil, err
+	}
+	return fv, nil
+}
+
+// PruneFileVersions deletes the versions of the file beyond the keep latest,
+// unless keep is zero, and the ones created before the time, unless zero.
+// It returns the versions deleted.
+func PruneFileVersions(ctx context.Context, refID int64, keep int,
+	before time.Time) ([]*FileVersion, error) {
+
+	versions, err := GetFileVersions(ctx, refID)
+	if err != nil {
+		return nil, err
+	}
+	var pruned []*FileVersion
+	for i, fv := range versions {
+		if (keep > 0 && i >= keep) || (!before.IsZero() && fv.CreatedAt.Before(before)) {
+			pruned = append(pruned, fv)
+		}
+	}
+	return pruned, deleteFileVersions(ctx, pruned)
+}
+
+// PruneExpiredFileVersions deletes the versions of the allocation created
+// before the time, and returns them.
+func PruneExpiredFileVersions(ctx context.Context, allocationID string, before time.Time) ([]*FileVersion, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var pruned []*FileVersion
+	err := db.Where("allocation_id = ? AND created_at < ?", allocationID, before).
+		Find(&pruned).Error
+	if err != nil {
+		return nil, err
+	}
+	return pruned, deleteFileVersions(ctx, pruned)
+}
+
+// PruneOldestFileVersions deletes the oldest versions of the allocation, until
+// the size of the ones deleted reaches the size, and returns them.
+func PruneOldestFileVersions(ctx context.Context, allocationID string, size int64) ([]*FileVersion, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var versions []*FileVersion
+	err := db.Where("allocation_id = ?", allocationID).
+		Order("created_at, id").Find(&versions).Error
+	if err != nil {
+		return nil, err
+	}
+	var pruned []*FileVersion
+	for _, fv := range versions {
+		if size <= 0 {
+			break
+		}
+		pruned = append(pruned, fv)
+		size -= fv.Size
+	}
+	return pruned, deleteFileVersions(ctx, pruned)
+}
+
+// DeleteFileVersions deletes all the versions of the file, and returns them.
+func DeleteFileVersions(ctx context.Context, refID int64) ([]*FileVersion, error) {
+	versions, err := GetFileVersions(ctx, refID)
+	if err != nil {
+		return nil, err
+	}
+	return versions, deleteFileVersions(ctx, versions)
+}
+
+func deleteFileVersions(ctx context.Context, versions []*FileVersion) error {
+	if len(versions) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(versions))
+	for _, fv := range versions {
+		ids = append(ids, fv.ID)
+	}
+	db := datastore.GetStore().GetTransaction(ctx)
+	return db.Where("id IN ?", ids).Delete(&FileVersion{}).Error
+}
+
+// FileVersionsSize returns the size of the versions kept for the allocation.
+func FileVersionsSize(ctx context.Context, allocationID string) (int64, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var size int64
+	err := db.Model(&FileVersion{}).Select("COALESCE(SUM(size), 0)").
+		Where("allocation_id = ?", allocationID).Row().Scan(&size)
+	return size, err
+}
+
+// IsVersionObject tells whether a version of a file of the allocation refers
+// to the content or the thumbnail object of the hash.
+func IsVersionObject(ctx context.Context, allocationID, hash string) (bool, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var count int64
+	err := db.Model(&FileVersion{}).
+		Where("allocation_id = ? AND (content_hash = ? OR thumbnail_hash = ?)",
+			allocationID, hash, hash).
+		Count(&count).Error
+	return count > 0, err
+}
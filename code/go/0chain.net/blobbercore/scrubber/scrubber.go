@@ -0,0 +1,199 @@
+package scrubber
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/common"
+
+	"gorm.io/gorm/clause"
+)
+
+// The statuses of the scrub results.
+const (
+	// StatusOK is the status of a file matching its reference.
+	StatusOK = "ok"
+	// StatusCorrupted is the status of a file whose content does not match
+	// the content hash or the merkle root of its reference.
+	StatusCorrupted = "corrupted"
+	// StatusUnreadable is the status of a file whose object could not be
+	// opened, usually as it is missing.
+	StatusUnreadable = "unreadable"
+	// StatusRestored is the status of a corrupted or unreadable file
+	// restored from its cloud copy.
+	StatusRestored = "restored"
+)
+
+// Result is the outcome of the last check of a file.
+type Result struct {
+	RefID        int64     `gorm:"column:ref_id;primary_key" json:"ref_id"`
+	AllocationID string    `gorm:"column:allocation_id" json:"allocation_id"`
+	Path         string    `gorm:"column:path" json:"path"`
+	ContentHash  string    `gorm:"column:content_hash" json:"content_hash"`
+	Status       string    `gorm:"column:status" json:"status"`
+	Error        string    `gorm:"column:error" json:"error,omitempty"`
+	ScrubbedAt   time.Time `gorm:"column:scrubbed_at" json:"scrubbed_at"`
+}
+
+func (Result) TableName() string {
+	return "scrub_results"
+}
+
+// limiter limits the reads to bytesPerSecond on average since its start.
+type limiter struct {
+	bytesPerSecond int64
+	start          time.Time
+	read           int64
+}
+
+func newLimiter(bytesPerSecond int64) *limiter {
+	return &limiter{bytesPerSecond: bytesPerSecond, start: time.Now()}
+}
+
+// wait waits for the n bytes read to be within the rate.
+func (l *limiter) wait(ctx context.Context, n int) error {
+	if l == nil || l.bytesPerSecond <= 0 {
+		return ctx.Err()
+	}
+	l.read += int64(n)
+	due := l.start.Add(time.Duration(l.read * int64(time.Second) / l.bytesPerSecond))
+	delay := time.Until(due)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type limitedReader struct {
+	ctx context.Context
+	r   io.Reader
+	l   *limiter
+}
+
+func (lr *limitedReader) Read(p []byte) (int, error) {
+	n, err := lr.r.Read(p)
+	if werr := lr.l.wait(lr.ctx, n); werr != nil {
+		return n, werr
+	}
+	return n, err
+}
+
+func fileData(ref *reference.Ref) *filestore.FileInputData {
+	// OnCloud is left unset, for a missing object not to be fetched back
+	// from the cloud without being reported
+	return &filestore.FileInputData{Name: ref.Name, Path: ref.Path,
+		Hash: ref.ContentHash}
+}
+
+// verify reads the object of the file and checks it against the content hash
+// and the merkle root of the reference.
+func verify(ctx context.Context, ref *reference.Ref, l *limiter) (string, error) {
+	reader, _, err := filestore.GetFileStore().GetFileReader(ref.AllocationID, fileData(ref))
+	if err != nil {
+		return StatusUnreadable, err
+	}
+	defer reader.Close()
+
+	contentHash, merkleRoot, err := filestore.ComputeObjectHashes(
+		&limitedReader{ctx: ctx, r: reader, l: l})
+	if err != nil {
+		// e.g. a compressed object which fails to decompress
+		return StatusCorrupted, err
+	}
+	if contentHash != ref.ContentHash {
+		return StatusCorrupted, common.NewError("content_hash_mismatch",
+			"The content hash of the object is "+contentHash)
+	}
+	if merkleRoot != ref.MerkleRoot {
+		return StatusCorrupted, common.NewError("merkle_root_mismatch",
+			"The merkle root of the object is "+merkleRoot)
+	}
+	return StatusOK, nil
+}
+
+// restore replaces the object of the file with its cloud copy, and checks it.
+func restore(ctx context.Context, ref *reference.Ref, l *limiter) error {
+	restorer, ok := filestore.GetFileStore().(filestore.ObjectRestorer)
+	if !ok {
+		return common.NewError("not_supported",
+			"The file store does not restore objects from the cloud")
+	}
+	if err := restorer.RestoreFromCloud(ref.AllocationID, fileData(ref)); err != nil {
+		return err
+	}
+	if _, err := verify(ctx, ref, l); err != nil {
+		return err
+	}
+	return nil
+}
+
+// isCurrent tells whether the reference still is the one of a file of the
+// same content, and was neither updated nor deleted while being checked.
+func isCurrent(ref *reference.Ref) (bool, error) {
+	var hashes []string
+	err := datastore.GetStore().GetDB().Model(&reference.Ref{}).
+		Where("id = ?", ref.ID).Pluck("content_hash", &hashes).Error
+	if err != nil {
+		return false, err
+	}
+	return len(hashes) == 1 && hashes[0] == ref.ContentHash, nil
+}
+
+// scrubFile checks the object of the file against its reference, restoring it
+// from its cloud copy if it does not match and the configuration allows it.
+// It returns no result if the file changed meanwhile or the context is done.
+func scrubFile(ctx context.Context, ref *reference.Ref, l *limiter) (*Result, error) {
+	status, err := verify(ctx, ref, l)
+	if ctx.Err() != nil {
+		return nil, nil
+	}
+	result := &Result{
+		RefID:        ref.ID,
+		AllocationID: ref.AllocationID,
+		Path:         ref.Path,
+		ContentHash:  ref.ContentHash,
+		Status:       status,
+		ScrubbedAt:   time.Now(),
+	}
+	if err == nil {
+		return result, nil
+	}
+
+	current, cerr := isCurrent(ref)
+	if cerr != nil {
+		return nil, cerr
+	}
+	if !current {
+		return nil, nil
+	}
+	result.Error = err.Error()
+	if config.Configuration.ScrubRestoreFromCloud && ref.OnCloud {
+		if rerr := restore(ctx, ref, l); rerr != nil {
+			result.Error += ", restoring from the cloud: " + rerr.Error()
+		} else {
+			result.Status = StatusRestored
+		}
+	}
+	return result, nil
+}
+
+// saveResult keeps the result as the last one of its file.
+func saveResult(result *Result) error {
+	return datastore.GetStore().GetDB().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "ref_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"allocation_id", "path",
+			"content_hash", "status", "error", "scrubbed_at"}),
+	}).Create(result).Error
+}
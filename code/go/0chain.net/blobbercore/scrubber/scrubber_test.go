@@ -0,0 +1,170 @@
+package scrubber
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/internal/filestoretest"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/logging"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	scrubAllocationID = "scrub_allocation"
+	scrubStoreBackend = "scrub_test"
+)
+
+// damagedStore serves corrupted content for the corrupted hashes, and
+// restores the content of the cloud hashes.
+type damagedStore struct {
+	*filestore.MemFileStore
+	corrupted map[string]bool
+	cloud     map[string]bool
+}
+
+func (ds *damagedStore) GetFileReader(allocationID string, fileData *filestore.FileInputData) (filestore.ObjectReader, int64, error) {
+	reader, size, err := ds.MemFileStore.GetFileReader(allocationID, fileData)
+	if err != nil || !ds.corrupted[fileData.Hash] {
+		return reader, size, err
+	}
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, 0, err
+	}
+	content[0] ^= 0xff
+	return filestoretest.NewMemFile(content), size, nil
+}
+
+func (ds *damagedStore) RestoreFromCloud(allocationID string, fileData *filestore.FileInputData) error {
+	if !ds.cloud[fileData.Hash] {
+		return assert.AnError
+	}
+	delete(ds.corrupted, fileData.Hash)
+	return nil
+}
+
+func setupScrubber(t *testing.T) (*damagedStore, func()) {
+	logging.Logger = zap.NewNop()
+	datastore.OpenTheSQLiteStore(t)
+	store := &damagedStore{
+		MemFileStore: filestore.NewMemFileStore(),
+		corrupted:    make(map[string]bool),
+		cloud:        make(map[string]bool),
+	}
+	filestore.RegisterStore(scrubStoreBackend, func(string) (filestore.FileStore, error) {
+		return store, nil
+	})
+	_, err := filestore.SetupStore(scrubStoreBackend, "")
+	require.NoError(t, err)
+	prev := config.Configuration
+	return store, func() {
+		config.Configuration = prev
+		datastore.GetStore().Close()
+	}
+}
+
+// storeFile stores the content of the file, and its reference.
+func storeFile(t *testing.T, path, content string, onCloud bool) *reference.Ref {
+	fileData := &filestore.FileInputData{Name: path[1:], Path: path}
+	out, err := filestore.GetFileStore().WriteFile(scrubAllocationID, fileData,
+		filestoretest.NewMemFile([]byte(content)), "upload")
+	require.NoError(t, err)
+	fileData.Hash = out.ContentHash
+	_, err = filestore.GetFileStore().CommitWrite(scrubAllocationID, fileData, "upload")
+	require.NoError(t, err)
+
+	ref := &reference.Ref{Type: reference.FILE, AllocationID: scrubAllocationID,
+		Name: path[1:], Path: path, ParentPath: "/", PathLevel: 2,
+		LookupHash:  reference.GetReferenceLookup(scrubAllocationID, path),
+		ContentHash: out.ContentHash, MerkleRoot: out.MerkleRoot,
+		Size: out.Size, OnCloud: onCloud}
+	ref.PathHash = ref.LookupHash
+	require.NoError(t, datastore.GetStore().GetDB().Create(ref).Error)
+	return ref
+}
+
+func results(t *testing.T) map[string]*Result {
+	var rs []*Result
+	require.NoError(t, datastore.GetStore().GetDB().Find(&rs).Error)
+	byPath := make(map[string]*Result)
+	for _, r := range rs {
+		byPath[r.Path] = r
+	}
+	return byPath
+}
+
+func TestScrub(t *testing.T) {
+	store, teardown := setupScrubber(t)
+	defer teardown()
+
+	storeFile(t, "/ok.txt", "the content of a sound file", false)
+	corrupted := storeFile(t, "/corrupted.txt", "the content of a corrupted file", false)
+	missing := storeFile(t, "/missing.txt", "the content of a missing file", false)
+	restored := storeFile(t, "/restored.txt", "the content of a restored file", true)
+	store.corrupted[corrupted.ContentHash] = true
+	store.corrupted[restored.ContentHash] = true
+	store.cloud[restored.ContentHash] = true
+	require.NoError(t, store.DeleteFile(scrubAllocationID, missing.ContentHash))
+
+	scrub(context.Background())
+	rs := results(t)
+	require.Len(t, rs, 4)
+	assert.Equal(t, StatusOK, rs["/ok.txt"].Status)
+	assert.Empty(t, rs["/ok.txt"].Error)
+	assert.Equal(t, StatusCorrupted, rs["/corrupted.txt"].Status)
+	assert.Contains(t, rs["/corrupted.txt"].Error, "content_hash_mismatch")
+	assert.Equal(t, StatusUnreadable, rs["/missing.txt"].Status)
+	assert.Equal(t, StatusCorrupted, rs["/restored.txt"].Status)
+
+	// the corrupted files are restored from their cloud copies
+	config.Configuration.ScrubRestoreFromCloud = true
+	scrub(context.Background())
+	rs = results(t)
+	assert.Equal(t, StatusRestored, rs["/restored.txt"].Status)
+	assert.Equal(t, StatusCorrupted, rs["/corrupted.txt"].Status)
+	scrub(context.Background())
+	assert.Equal(t, StatusOK, results(t)["/restored.txt"].Status)
+
+	// the results of the deleted files are removed
+	require.NoError(t, datastore.GetStore().GetDB().Delete(corrupted).Error)
+	scrub(context.Background())
+	rs = results(t)
+	assert.Len(t, rs, 3)
+	assert.NotContains(t, rs, "/corrupted.txt")
+}
+
+func TestScrub_ChangedFile(t *testing.T) {
+	store, teardown := setupScrubber(t)
+	defer teardown()
+
+	ref := storeFile(t, "/a.txt", "the content of the file", false)
+	store.corrupted[ref.ContentHash] = true
+	require.NoError(t, datastore.GetStore().GetDB().Model(&reference.Ref{}).
+		Where("id = ?", ref.ID).Update("content_hash", "updated").Error)
+
+	result, err := scrubFile(context.Background(), ref, nil)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestLimiter(t *testing.T) {
+	l := newLimiter(100000)
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, l.wait(context.Background(), 1000))
+	}
+	assert.True(t, time.Since(start) >= 90*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Error(t, l.wait(ctx, 100000))
+}
@@ -0,0 +1,111 @@
+package scrubber
+
+import (
+	"context"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/reference"
+	. "0chain.net/core/logging"
+	"0chain.net/core/metrics"
+
+	"go.uber.org/zap"
+)
+
+// scrubBatch is the number of file references read at once.
+const scrubBatch = 100
+
+// StartWorker checks all the stored files against their references at every
+// interval, when the interval is positive.
+func StartWorker(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		tk := time.NewTicker(interval)
+		defer tk.Stop()
+		for {
+			select {
+			case <-tk.C:
+				start := time.Now()
+				scrub(ctx)
+				metrics.ObserveWorkerLoop("scrubber", start)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// scrub checks the files of all the allocations, in the order of their
+// references, and removes the results of the files deleted since.
+func scrub(ctx context.Context) {
+	var (
+		l       = newLimiter(config.Configuration.ScrubBytesPerSecond)
+		lastID  int64
+		counts  = make(map[string]int)
+		skipped int
+	)
+	for {
+		var refs []*reference.Ref
+		err := datastore.GetStore().GetDB().
+			Select("id, allocation_id, name, path, content_hash, merkle_root, on_cloud").
+			Where("type = ? AND id > ?", reference.FILE, lastID).
+			Order("id").Limit(scrubBatch).Find(&refs).Error
+		if err != nil {
+			Logger.Error("Reading the files to scrub", zap.Error(err))
+			return
+		}
+		for _, ref := range refs {
+			lastID = ref.ID
+			// the local copy of the cold files may be deleted on purpose
+			if ref.ContentHash == "" ||
+				ref.OnCloud && config.Configuration.ColdStorageDeleteLocalCopy {
+				skipped++
+				continue
+			}
+			result, err := scrubFile(ctx, ref, l)
+			if ctx.Err() != nil {
+				return
+			}
+			if err == nil && result != nil {
+				err = saveResult(result)
+			}
+			if err != nil {
+				Logger.Error("Scrubbing the file", zap.Int64("ref_id", ref.ID),
+					zap.String("allocation_id", ref.AllocationID), zap.Error(err))
+				continue
+			}
+			if result == nil {
+				skipped++
+				continue
+			}
+			counts[result.Status]++
+			if result.Status != StatusOK {
+				Logger.Warn("Scrubbed a damaged file",
+					zap.String("allocation_id", ref.AllocationID),
+					zap.String("path", ref.Path), zap.String("status", result.Status),
+					zap.String("error", result.Error))
+			}
+		}
+		if len(refs) < scrubBatch {
+			break
+		}
+	}
+
+	if err := purgeResults(); err != nil {
+		Logger.Error("Purging the scrub results", zap.Error(err))
+	}
+	Logger.Info("Scrubbed the files", zap.Any("statuses", counts),
+		zap.Int("skipped", skipped))
+}
+
+// purgeResults removes the results of the files no longer referenced.
+func purgeResults() error {
+	return datastore.GetStore().GetDB().Exec(`
+		DELETE FROM scrub_results WHERE NOT EXISTS (
+			SELECT 1 FROM reference_objects
+			WHERE reference_objects.id = scrub_results.ref_id
+			AND reference_objects.deleted_at IS NULL)`).Error
+}
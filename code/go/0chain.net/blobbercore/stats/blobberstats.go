@@ -65,6 +65,30 @@ type MinioStats struct {
 	LastMinioScan   string `json:"last_minio_scan"`
 }
 
+// MaxScrubFailures is the max number of the damaged files listed in the
+// scrub stats.
+const MaxScrubFailures = 100
+
+// ScrubFailure is a file found damaged by its last scrub.
+type ScrubFailure struct {
+	RefID        int64     `gorm:"column:ref_id" json:"ref_id"`
+	AllocationID string    `gorm:"column:allocation_id" json:"allocation_id"`
+	Path         string    `gorm:"column:path" json:"path"`
+	Status       string    `gorm:"column:status" json:"status"`
+	Error        string    `gorm:"column:error" json:"error"`
+	ScrubbedAt   time.Time `gorm:"column:scrubbed_at" json:"scrubbed_at"`
+}
+
+// ScrubStats are the results of the last scrub of the stored files.
+type ScrubStats struct {
+	ScrubbedFiles   int64           `json:"scrubbed_files"`
+	CorruptedFiles  int64           `json:"corrupted_files"`
+	UnreadableFiles int64           `json:"unreadable_files"`
+	RestoredFiles   int64           `json:"restored_files"`
+	LastScrub       string          `json:"last_scrub"`
+	ScrubFailures   []*ScrubFailure `json:"scrub_failures"`
+}
+
 type Duration int64
 
 func (d Duration) String() string {
@@ -74,10 +98,15 @@ func (d Duration) String() string {
 type BlobberStats struct {
 	Stats
 	MinioStats
+	ScrubStats
 	NumAllocation int64  `json:"num_of_allocations"`
 	ClientID      string `json:"-"`
 	PublicKey     string `json:"-"`
 
+	// LogicalSizeUsed is the size of the stored content before compression
+	// and deduplication; DiskSizeUsed is what it physically takes.
+	LogicalSizeUsed int64 `json:"logical_size_used"`
+
 	// configurations
 	Capacity                int64         `json:"capacity"`
 	ReadPrice               float64       `json:"read_price"`
@@ -125,8 +154,14 @@ func (bs *BlobberStats) loadBasicStats(ctx context.Context) {
 		du = -1
 	}
 	bs.DiskSizeUsed = du
+	lu, err := filestore.GetFileStore().GetTotalLogicalSizeUsed()
+	if err != nil {
+		lu = -1
+	}
+	bs.LogicalSizeUsed = lu
 	bs.loadStats(ctx)
 	bs.loadMinioStats(ctx)
+	bs.loadScrubStats(ctx)
 }
 
 func (bs *BlobberStats) loadDetailedStats(ctx context.Context) {
@@ -235,6 +270,50 @@ func (bs *BlobberStats) loadMinioStats(ctx context.Context) {
 	bs.LastMinioScan = LastMinioScan.Format(DateTimeFormat)
 }
 
+func (bs *BlobberStats) loadScrubStats(ctx context.Context) {
+
+	var (
+		db  = datastore.GetStore().GetTransaction(ctx)
+		row *sql.Row
+		err error
+	)
+
+	row = db.Table("scrub_results").
+		Select(`
+			COUNT (*) AS scrubbed_files,
+			COALESCE (SUM (CASE WHEN status = 'corrupted' THEN 1 ELSE 0 END), 0) AS corrupted_files,
+			COALESCE (SUM (CASE WHEN status = 'unreadable' THEN 1 ELSE 0 END), 0) AS unreadable_files,
+			COALESCE (SUM (CASE WHEN status = 'restored' THEN 1 ELSE 0 END), 0) AS restored_files`).
+		Row()
+
+	err = row.Scan(&bs.ScrubbedFiles, &bs.CorruptedFiles, &bs.UnreadableFiles,
+		&bs.RestoredFiles)
+	if err != nil && err != sql.ErrNoRows {
+		Logger.Error("Error in scanning record for scrub stats",
+			zap.Error(err))
+		return
+	}
+
+	var last []time.Time
+	err = db.Table("scrub_results").Order("scrubbed_at DESC").Limit(1).
+		Pluck("scrubbed_at", &last).Error
+	if err != nil {
+		Logger.Error("Error in getting the last scrub", zap.Error(err))
+		return
+	}
+	if len(last) > 0 {
+		bs.LastScrub = last[0].Format(DateTimeFormat)
+	}
+
+	bs.ScrubFailures = make([]*ScrubFailure, 0)
+	err = db.Table("scrub_results").Where("status <> 'ok'").
+		Order("scrubbed_at DESC").Limit(MaxScrubFailures).
+		Find(&bs.ScrubFailures).Error
+	if err != nil {
+		Logger.Error("Error in getting the scrub failures", zap.Error(err))
+	}
+}
+
 func (bs *BlobberStats) loadAllocationStats(ctx context.Context) {
 	bs.AllocationStats = make([]*AllocationStats, 0)
 
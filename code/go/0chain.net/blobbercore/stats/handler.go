@@ -75,6 +75,10 @@ const tpl = `<!DOCTYPE html>
         <td>Actual Disk Usage (bytes)</td>
         <td>{{ .DiskSizeUsed }}</td>
       </tr>
+      <tr>
+        <td>Logical Disk Usage (bytes)</td>
+        <td>{{ .LogicalSizeUsed }}</td>
+      </tr>
       <tr>
         <td>Cloud Files Size (bytes)</td>
         <td>{{ .CloudFilesSize }}</td>
@@ -87,6 +91,26 @@ const tpl = `<!DOCTYPE html>
         <td>Last Minio Scan</td>
         <td>{{ .LastMinioScan }}</td>
       </tr>
+      <tr>
+        <td>Scrubbed Files</td>
+        <td>{{ .ScrubbedFiles }}</td>
+      </tr>
+      <tr>
+        <td>Corrupted Files</td>
+        <td>{{ .CorruptedFiles }}</td>
+      </tr>
+      <tr>
+        <td>Unreadable Files</td>
+        <td>{{ .UnreadableFiles }}</td>
+      </tr>
+      <tr>
+        <td>Restored Files</td>
+        <td>{{ .RestoredFiles }}</td>
+      </tr>
+      <tr>
+        <td>Last Scrub</td>
+        <td>{{ .LastScrub }}</td>
+      </tr>
       <tr>
         <td>Num of files</td>
         <td>{{ .NumWrites }}</td>
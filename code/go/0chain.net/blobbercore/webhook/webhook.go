@@ -0,0 +1,214 @@
+// Package webhook notifies the webhooks of the configuration of the events
+// of the blobber. The deliveries are queued in the metadata database with
+// the changes they notify, and sent by a worker retrying the failed ones.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+	"0chain.net/core/node"
+
+	"gorm.io/gorm"
+)
+
+// The events notified to the webhooks.
+const (
+	EventCommit              = "commit"
+	EventWriteMarkerRedeemed = "write_marker_redeemed"
+	EventWriteMarkerFailed   = "write_marker_failed"
+	EventReadMarkerRedeemed  = "read_marker_redeemed"
+	EventReadMarkerFailed    = "read_marker_failed"
+	EventChallengeFailed     = "challenge_failed"
+	EventAllocationFinalized = "allocation_finalized"
+	EventAllocationCleanedUp = "allocation_cleaned_up"
+	EventCapacityThreshold   = "capacity_threshold"
+)
+
+// The statuses of the deliveries.
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusFailed    = "failed"
+)
+
+// Delivery is a payload to deliver, or delivered, to a webhook.
+type Delivery struct {
+	ID             int64     `gorm:"column:id;primary_key" json:"id"`
+	URL            string    `gorm:"column:url" json:"url"`
+	Event          string    `gorm:"column:event" json:"event"`
+	Payload        string    `gorm:"column:payload" json:"payload"`
+	Status         string    `gorm:"column:status" json:"status"`
+	Attempts       int       `gorm:"column:attempts" json:"attempts"`
+	NextAttemptAt  time.Time `gorm:"column:next_attempt_at" json:"next_attempt_at"`
+	ResponseStatus int       `gorm:"column:response_status" json:"response_status,omitempty"`
+	LastError      string    `gorm:"column:last_error" json:"last_error,omitempty"`
+	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (Delivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// Payload is the body posted to the webhooks.
+type Payload struct {
+	Event     string           `json:"event"`
+	BlobberID string           `json:"blobber_id"`
+	Timestamp common.Timestamp `json:"timestamp"`
+	Data      interface{}      `json:"data"`
+}
+
+// CommitData is the data of the commit event.
+type CommitData struct {
+	AllocationID       string `json:"allocation_id"`
+	AllocationRoot     string `json:"allocation_root"`
+	PrevAllocationRoot string `json:"prev_allocation_root"`
+	ClientID           string `json:"client_id"`
+	Size               int64  `json:"size"`
+}
+
+// MarkerData is the data of the events of the redeeming of the write and
+// read markers.
+type MarkerData struct {
+	AllocationID   string `json:"allocation_id"`
+	ClientID       string `json:"client_id"`
+	AllocationRoot string `json:"allocation_root,omitempty"`
+	ReadCounter    int64  `json:"read_counter,omitempty"`
+	TxnHash        string `json:"txn_hash,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// ChallengeData is the data of the challenge failed event.
+type ChallengeData struct {
+	ChallengeID  string `json:"challenge_id"`
+	AllocationID string `json:"allocation_id"`
+	Error        string `json:"error,omitempty"`
+}
+
+// AllocationData is the data of the allocation finalized and cleaned up
+// events.
+type AllocationData struct {
+	AllocationID string `json:"allocation_id"`
+}
+
+func subscribed(hook config.WebhookConfig, event string) bool {
+	if len(hook.Events) == 0 {
+		return true
+	}
+	for _, e := range hook.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Notify queues the delivery of the event to the webhooks subscribed to it,
+// in the transaction of the context. They are sent once it is committed.
+func Notify(ctx context.Context, event string, data interface{}) error {
+	var hooks []config.WebhookConfig
+	for _, hook := range config.Configuration.Webhooks {
+		if subscribed(hook, event) {
+			hooks = append(hooks, hook)
+		}
+	}
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(&Payload{
+		Event:     event,
+		BlobberID: node.Self.ID,
+		Timestamp: common.Now(),
+		Data:      data,
+	})
+	if err != nil {
+		return err
+	}
+	db := datastore.GetStore().GetTransaction(ctx)
+	now := time.Now()
+	// in a savepoint, the transaction is left usable if queuing fails, the
+	// callers logging the failure only
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for _, hook := range hooks {
+			err := tx.Create(&Delivery{
+				URL:           hook.URL,
+				Event:         event,
+				Payload:       string(payload),
+				Status:        StatusPending,
+				NextAttemptAt: now,
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	datastore.GetStore().OnCommit(ctx, wakeUp)
+	return nil
+}
+
+const (
+	// DefaultLogLimit is the number of deliveries of a page of the log when
+	// the request does not set it.
+	DefaultLogLimit = 100
+	// MaxLogLimit is the max number of deliveries of a page of the log.
+	MaxLogLimit = 1000
+)
+
+// DeliveryFilter selects the deliveries of the log.
+type DeliveryFilter struct {
+	Status string
+	Event  string
+	// Before is the id the deliveries are older than, zero for the latest.
+	Before int64
+	Limit  int
+}
+
+// DeliveryLog is a page of the delivery log.
+type DeliveryLog struct {
+	Deliveries []*Delivery `json:"deliveries"`
+	// NextBefore is the filter of the next page, zero for the last one.
+	NextBefore int64 `json:"next_before,omitempty"`
+}
+
+// GetDeliveries returns the page of the delivery log selected by the
+// filter, the latest deliveries first.
+func GetDeliveries(ctx context.Context, filter DeliveryFilter) (*DeliveryLog, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultLogLimit
+	} else if limit > MaxLogLimit {
+		limit = MaxLogLimit
+	}
+	db := datastore.GetStore().GetTransaction(ctx).Model(&Delivery{})
+	if filter.Status != "" {
+		db = db.Where("status = ?", filter.Status)
+	}
+	if filter.Event != "" {
+		db = db.Where("event = ?", filter.Event)
+	}
+	if filter.Before > 0 {
+		db = db.Where("id < ?", filter.Before)
+	}
+	log := &DeliveryLog{Deliveries: make([]*Delivery, 0)}
+	err := db.Order("id DESC").Limit(limit + 1).Find(&log.Deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(log.Deliveries) > limit {
+		log.Deliveries = log.Deliveries[:limit]
+		log.NextBefore = log.Deliveries[limit-1].ID
+	}
+	return log, nil
+}
@@ -0,0 +1,190 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupWebhooks(t *testing.T, hooks ...config.WebhookConfig) func() {
+	datastore.OpenTheSQLiteStore(t)
+	prev := config.Configuration
+	config.Configuration.Webhooks = hooks
+	config.Configuration.WebhookMaxAttempts = 3
+	config.Configuration.WebhookRetryBackoff = time.Second
+	config.Configuration.WebhookMaxRetryBackoff = time.Minute
+	config.Configuration.WebhookTimeout = 5 * time.Second
+	return func() {
+		config.Configuration = prev
+		datastore.GetStore().Close()
+	}
+}
+
+func notify(t *testing.T, event string, data interface{}) {
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	require.NoError(t, Notify(ctx, event, data))
+	require.NoError(t, datastore.GetStore().Commit(ctx))
+}
+
+func deliveries(t *testing.T) []*Delivery {
+	var ds []*Delivery
+	require.NoError(t, datastore.GetStore().GetDB().Order("id").Find(&ds).Error)
+	return ds
+}
+
+func TestNotify_Events(t *testing.T) {
+	defer setupWebhooks(t,
+		config.WebhookConfig{URL: "http://all"},
+		config.WebhookConfig{URL: "http://commits", Events: []string{EventCommit}})()
+
+	notify(t, EventCommit, &CommitData{AllocationID: "alloc"})
+	notify(t, EventChallengeFailed, &ChallengeData{ChallengeID: "challenge"})
+
+	ds := deliveries(t)
+	require.Len(t, ds, 3)
+	var urls []string
+	for _, d := range ds {
+		urls = append(urls, d.URL+" "+d.Event)
+		assert.Equal(t, StatusPending, d.Status)
+	}
+	assert.ElementsMatch(t, []string{"http://all commit",
+		"http://commits commit", "http://all challenge_failed"}, urls)
+
+	var payload struct {
+		Event string     `json:"event"`
+		Data  CommitData `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ds[0].Payload), &payload))
+	assert.Equal(t, EventCommit, payload.Event)
+	assert.Equal(t, "alloc", payload.Data.AllocationID)
+}
+
+func TestNotify_Failure(t *testing.T) {
+	defer setupWebhooks(t,
+		config.WebhookConfig{URL: "http://all"},
+		config.WebhookConfig{URL: "http://failing", Events: []string{EventChallengeFailed}})()
+	require.NoError(t, datastore.GetStore().GetDB().Exec(`CREATE TRIGGER failing_delivery
+		BEFORE INSERT ON webhook_deliveries WHEN NEW.url = 'http://failing'
+		BEGIN SELECT RAISE(ABORT, 'failing'); END`).Error)
+
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	assert.Error(t, Notify(ctx, EventChallengeFailed, &ChallengeData{ChallengeID: "challenge"}))
+	// none of the deliveries of the event is queued, the transaction goes on
+	require.NoError(t, Notify(ctx, EventCommit, &CommitData{AllocationID: "alloc"}))
+	require.NoError(t, datastore.GetStore().Commit(ctx))
+
+	ds := deliveries(t)
+	require.Len(t, ds, 1)
+	assert.Equal(t, "http://all", ds[0].URL)
+	assert.Equal(t, EventCommit, ds[0].Event)
+}
+
+func TestDeliver(t *testing.T) {
+	var (
+		requests = make(chan *http.Request, 10)
+		bodies   = make(chan []byte, 10)
+		fail     = int32(1)
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- r
+		bodies <- body
+		if atomic.LoadInt32(&fail) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+	defer setupWebhooks(t, config.WebhookConfig{URL: server.URL, Secret: "secret"})()
+
+	notify(t, EventCommit, &CommitData{AllocationID: "alloc"})
+	deliverDue(context.Background())
+
+	d := deliveries(t)[0]
+	assert.Equal(t, StatusPending, d.Status)
+	assert.Equal(t, 1, d.Attempts)
+	assert.Equal(t, http.StatusInternalServerError, d.ResponseStatus)
+	assert.NotEmpty(t, d.LastError)
+	assert.True(t, d.NextAttemptAt.After(time.Now()))
+	<-requests
+	<-bodies
+
+	// not due yet
+	deliverDue(context.Background())
+	assert.Equal(t, 1, deliveries(t)[0].Attempts)
+
+	atomic.StoreInt32(&fail, 0)
+	require.NoError(t, datastore.GetStore().GetDB().Model(d).
+		Update("next_attempt_at", time.Now().Add(-time.Second)).Error)
+	deliverDue(context.Background())
+
+	d = deliveries(t)[0]
+	assert.Equal(t, StatusDelivered, d.Status)
+	assert.Equal(t, 2, d.Attempts)
+	assert.Empty(t, d.LastError)
+
+	r, body := <-requests, <-bodies
+	assert.Equal(t, d.Payload, string(body))
+	assert.Equal(t, EventCommit, r.Header.Get(HeaderEvent))
+	assert.Equal(t, Sign("secret", r.Header.Get(HeaderTimestamp), body),
+		r.Header.Get(HeaderSignature))
+}
+
+func TestDeliver_MaxAttempts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+	defer setupWebhooks(t, config.WebhookConfig{URL: server.URL})()
+
+	notify(t, EventCommit, nil)
+	for i := 0; i < config.Configuration.WebhookMaxAttempts; i++ {
+		require.NoError(t, datastore.GetStore().GetDB().Model(&Delivery{}).
+			Where("1 = 1").Update("next_attempt_at", time.Now().Add(-time.Second)).Error)
+		deliverDue(context.Background())
+	}
+
+	d := deliveries(t)[0]
+	assert.Equal(t, StatusFailed, d.Status)
+	assert.Equal(t, config.Configuration.WebhookMaxAttempts, d.Attempts)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	defer setupWebhooks(t)()
+	assert.Equal(t, time.Second, retryBackoff(1))
+	assert.Equal(t, 4*time.Second, retryBackoff(3))
+	assert.Equal(t, time.Minute, retryBackoff(10))
+}
+
+func TestGetDeliveries(t *testing.T) {
+	defer setupWebhooks(t, config.WebhookConfig{URL: "http://hook"})()
+	for i := 0; i < 3; i++ {
+		notify(t, EventCommit, nil)
+	}
+	notify(t, EventChallengeFailed, nil)
+
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	defer datastore.GetStore().GetTransaction(ctx).Rollback()
+
+	log, err := GetDeliveries(ctx, DeliveryFilter{Event: EventCommit, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, log.Deliveries, 2)
+	assert.True(t, log.Deliveries[0].ID > log.Deliveries[1].ID)
+	require.NotZero(t, log.NextBefore)
+
+	log, err = GetDeliveries(ctx, DeliveryFilter{Event: EventCommit,
+		Before: log.NextBefore, Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, log.Deliveries, 1)
+	assert.Zero(t, log.NextBefore)
+}
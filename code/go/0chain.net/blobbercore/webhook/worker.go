@@ -0,0 +1,254 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	. "0chain.net/core/logging"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// pollInterval is the interval at which the due deliveries are sent,
+	// besides when deliveries are queued.
+	pollInterval = 5 * time.Second
+	// deliveryBatch is the number of due deliveries read at once.
+	deliveryBatch = 100
+	// purgeInterval is the interval at which the deliveries past the log
+	// retention are purged.
+	purgeInterval = time.Hour
+	// capacityInterval is the interval at which the used capacity is
+	// checked against the threshold.
+	capacityInterval = time.Minute
+)
+
+// The headers of the deliveries.
+const (
+	HeaderEvent     = "X-Blobber-Event"
+	HeaderDelivery  = "X-Blobber-Delivery"
+	HeaderTimestamp = "X-Blobber-Timestamp"
+	HeaderSignature = "X-Blobber-Signature"
+)
+
+var wake = make(chan struct{}, 1)
+
+func wakeUp() {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// Sign returns the signature of the body posted at the timestamp, the hex
+// HMAC-SHA256 of "<timestamp>.<body>" keyed with the secret of the webhook.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// StartWorker starts sending the queued deliveries to the webhooks, purging
+// the delivery log and notifying the crossings of the capacity threshold.
+func StartWorker(ctx context.Context) {
+	if len(config.Configuration.Webhooks) == 0 {
+		return
+	}
+	go func() {
+		poll := time.NewTicker(pollInterval)
+		defer poll.Stop()
+		purge := time.NewTicker(purgeInterval)
+		defer purge.Stop()
+		capacity := time.NewTicker(capacityInterval)
+		defer capacity.Stop()
+		var above bool
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-wake:
+				deliverDue(ctx)
+			case <-poll.C:
+				deliverDue(ctx)
+			case <-purge.C:
+				purgeDeliveries()
+			case <-capacity.C:
+				above = checkCapacity(ctx, above)
+			}
+		}
+	}()
+}
+
+func findHook(url string) (config.WebhookConfig, bool) {
+	for _, hook := range config.Configuration.Webhooks {
+		if hook.URL == url {
+			return hook, true
+		}
+	}
+	return config.WebhookConfig{}, false
+}
+
+// retryBackoff returns the time to wait for after the attempts.
+func retryBackoff(attempts int) time.Duration {
+	backoff := config.Configuration.WebhookRetryBackoff
+	max := config.Configuration.WebhookMaxRetryBackoff
+	for i := 1; i < attempts && backoff < max; i++ {
+		backoff *= 2
+	}
+	if max > 0 && backoff > max {
+		backoff = max
+	}
+	return backoff
+}
+
+func deliverDue(ctx context.Context) {
+	for {
+		var due []*Delivery
+		err := datastore.GetStore().GetDB().
+			Where("status = ? AND next_attempt_at <= ?", StatusPending, time.Now()).
+			Order("id").Limit(deliveryBatch).Find(&due).Error
+		if err != nil {
+			Logger.Error("Finding the due webhook deliveries", zap.Error(err))
+			return
+		}
+		for _, d := range due {
+			if ctx.Err() != nil {
+				return
+			}
+			attempt(ctx, d)
+		}
+		if len(due) < deliveryBatch {
+			return
+		}
+	}
+}
+
+// attempt sends the delivery to its webhook and records the outcome.
+func attempt(ctx context.Context, d *Delivery) {
+	d.Attempts++
+	hook, ok := findHook(d.URL)
+	if !ok {
+		d.Status = StatusFailed
+		d.LastError = "webhook no longer configured"
+	} else if d.ResponseStatus, d.LastError = send(ctx, hook, d); d.LastError == "" {
+		d.Status = StatusDelivered
+	} else if d.Attempts >= config.Configuration.WebhookMaxAttempts {
+		d.Status = StatusFailed
+	} else {
+		d.NextAttemptAt = time.Now().Add(retryBackoff(d.Attempts))
+	}
+	d.UpdatedAt = time.Now()
+	if err := datastore.GetStore().GetDB().Save(d).Error; err != nil {
+		Logger.Error("Saving the webhook delivery",
+			zap.Int64("id", d.ID), zap.Error(err))
+	}
+}
+
+// send posts the delivery to the webhook, returning the status of the
+// response, and the error if it is not delivered.
+func send(ctx context.Context, hook config.WebhookConfig, d *Delivery) (int, string) {
+	ctx, cancel := context.WithTimeout(ctx, config.Configuration.WebhookTimeout)
+	defer cancel()
+
+	body := []byte(d.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL,
+		bytes.NewReader(body))
+	if err != nil {
+		return 0, err.Error()
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, d.Event)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(d.ID, 10))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	if hook.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(hook.Secret, timestamp, body))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err.Error()
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Sprintf("unexpected response status %d",
+			resp.StatusCode)
+	}
+	return resp.StatusCode, ""
+}
+
+func purgeDeliveries() {
+	retention := config.Configuration.WebhookLogRetention
+	if retention <= 0 {
+		return
+	}
+	err := datastore.GetStore().GetDB().
+		Where("status <> ? AND updated_at < ?", StatusPending, time.Now().Add(-retention)).
+		Delete(&Delivery{}).Error
+	if err != nil {
+		Logger.Error("Purging the webhook delivery log", zap.Error(err))
+	}
+}
+
+// CapacityData is the data of the capacity threshold event.
+type CapacityData struct {
+	Capacity  int64   `json:"capacity"`
+	Used      int64   `json:"used"`
+	Threshold float64 `json:"threshold"`
+	// Above tells whether the used capacity crossed the threshold upwards,
+	// or downwards otherwise.
+	Above bool `json:"above"`
+}
+
+// checkCapacity notifies the crossing of the capacity threshold, if the
+// used capacity is no longer above, or below, it. It returns whether the
+// used capacity is above the threshold.
+func checkCapacity(ctx context.Context, above bool) bool {
+	threshold := config.Configuration.WebhookCapacityThreshold
+	capacity := config.Configuration.Capacity
+	if threshold <= 0 || capacity <= 0 {
+		return above
+	}
+	var used int64
+	err := datastore.GetStore().GetDB().Table("allocations").
+		Select("COALESCE(SUM(blobber_size_used), 0)").Row().Scan(&used)
+	if err != nil {
+		Logger.Error("Getting the used capacity", zap.Error(err))
+		return above
+	}
+	now := float64(used) >= threshold*float64(capacity)
+	if now == above {
+		return above
+	}
+
+	ctx = datastore.GetStore().CreateTransaction(ctx)
+	err = Notify(ctx, EventCapacityThreshold, &CapacityData{
+		Capacity:  capacity,
+		Used:      used,
+		Threshold: threshold,
+		Above:     now,
+	})
+	if err == nil {
+		err = datastore.GetStore().Commit(ctx)
+	}
+	if err != nil {
+		datastore.GetStore().GetTransaction(ctx).Rollback()
+		Logger.Error("Notifying the capacity threshold", zap.Error(err))
+		return above
+	}
+	return now
+}
@@ -7,7 +7,11 @@ import (
 
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/webhook"
 	"0chain.net/core/common"
+	. "0chain.net/core/logging"
+
+	"go.uber.org/zap"
 )
 
 type WriteMarker struct {
@@ -66,6 +70,15 @@ func (wm *WriteMarkerEntity) UpdateStatus(ctx context.Context,
 			CloseTxnID:     redeemTxn,
 			ReedeemRetries: wm.ReedeemRetries,
 		}).Error
+		if err != nil {
+			return
+		}
+		// not to fail the update, notifying is logged only
+		notifyErr := webhook.Notify(ctx, webhook.EventWriteMarkerFailed,
+			wm.webhookData(redeemTxn, statusMessage))
+		if notifyErr != nil {
+			Logger.Error("Notifying the write marker failure", zap.Error(notifyErr))
+		}
 		return
 	}
 
@@ -77,6 +90,13 @@ func (wm *WriteMarkerEntity) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return
 	}
+	if status == Committed {
+		notifyErr := webhook.Notify(ctx, webhook.EventWriteMarkerRedeemed,
+			wm.webhookData(redeemTxn, ""))
+		if notifyErr != nil {
+			Logger.Error("Notifying the write marker redeem", zap.Error(notifyErr))
+		}
+	}
 
 	// TODO (sfxdx): what about failed write markers ?
 	if status != Committed || wm.WM.Size <= 0 {
@@ -98,6 +118,16 @@ func (wm *WriteMarkerEntity) UpdateStatus(ctx context.Context,
 	return
 }
 
+func (wm *WriteMarkerEntity) webhookData(redeemTxn, errMsg string) *webhook.MarkerData {
+	return &webhook.MarkerData{
+		AllocationID:   wm.WM.AllocationID,
+		ClientID:       wm.WM.ClientID,
+		AllocationRoot: wm.WM.AllocationRoot,
+		TxnHash:        redeemTxn,
+		Error:          errMsg,
+	}
+}
+
 func GetWriteMarkerEntity(ctx context.Context, allocation_root string) (*WriteMarkerEntity, error) {
 	db := datastore.GetStore().GetTransaction(ctx)
 	wm := &WriteMarkerEntity{}
@@ -7,7 +7,9 @@ import (
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/config"
 	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/metrics"
 	. "0chain.net/core/logging"
+	coremetrics "0chain.net/core/metrics"
 	"github.com/remeh/sizedwaitgroup"
 
 	"go.uber.org/zap"
@@ -21,7 +23,8 @@ func RedeemMarkersForAllocation(ctx context.Context, allocationObj *allocation.A
 	rctx := datastore.GetStore().CreateTransaction(ctx)
 	db := datastore.GetStore().GetTransaction(rctx)
 	defer func() {
-		err := db.Commit().Error
+		// through the store, its hooks wake the webhooks worker up
+		err := datastore.GetStore().Commit(rctx)
 		if err != nil {
 			Logger.Error("Error committing the writemarker redeem", zap.Error(err))
 		}
@@ -44,6 +47,7 @@ func RedeemMarkersForAllocation(ctx context.Context, allocationObj *allocation.A
 		}
 		if startredeem || len(allocationObj.LatestRedeemedWM) == 0 {
 			err := wm.RedeemMarker(rctx)
+			metrics.Redeemed(metrics.WriteMarkerRedeems, err)
 			if err != nil {
 				Logger.Error("Error redeeming the write marker.", zap.Any("wm", wm.WM.AllocationID), zap.Any("error", err))
 				continue
@@ -77,6 +81,7 @@ func RedeemWriteMarkers(ctx context.Context) {
 		case <-ticker.C:
 			// Logger.Info("Trying to redeem writemarkers.",
 			//	zap.Any("numOfWorkers", numOfWorkers))
+			start := time.Now()
 			rctx := datastore.GetStore().CreateTransaction(ctx)
 			db := datastore.GetStore().GetTransaction(rctx)
 			allocations := make([]*allocation.Allocation, 0)
@@ -98,6 +103,7 @@ func RedeemWriteMarkers(ctx context.Context) {
 			}
 			db.Rollback()
 			rctx.Done()
+			coremetrics.ObserveWorkerLoop("writemarker_redeem", start)
 		}
 	}
 
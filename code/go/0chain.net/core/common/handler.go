@@ -5,9 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 )
 
 const (
@@ -68,6 +70,14 @@ func ToByteStream(handler JSONResponderF) ReqRespHandlerf {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		data, err := handler(ctx, r)
+		if rs, ok := data.(ResponseStreamer); ok {
+			defer rs.Close() //nolint:errcheck
+			if err == nil {
+				rs.StreamTo(w) //nolint:errcheck
+				return
+			}
+			data = nil
+		}
 		if err != nil {
 			if cerr, ok := err.(*Error); ok {
 				w.Header().Set(AppErrorHeader, cerr.Code)
@@ -94,6 +104,32 @@ func ToByteStream(handler JSONResponderF) ReqRespHandlerf {
 	}
 }
 
+/*ResponseStreamer - a handler response that writes itself to the response
+* writer, so that large content can be streamed instead of buffered. It is
+* closed once the request is done, whether it was streamed or not */
+type ResponseStreamer interface {
+	StreamTo(w http.ResponseWriter) error
+	Close() error
+}
+
+type connContextKey struct{}
+
+// ConnContext keeps the connection of the requests in their context, as the
+// ConnContext of the http.Server, see SetWriteDeadline.
+func ConnContext(ctx context.Context, c net.Conn) context.Context {
+	return context.WithValue(ctx, connContextKey{}, c)
+}
+
+// SetWriteDeadline sets the write deadline of the connection of the request
+// of the context, for a stream to outlive the write timeout of the server. It
+// does nothing for the requests of servers not using ConnContext.
+func SetWriteDeadline(ctx context.Context, t time.Time) error {
+	if c, ok := ctx.Value(connContextKey{}).(net.Conn); ok {
+		return c.SetWriteDeadline(t)
+	}
+	return nil
+}
+
 func SetupCORSResponse(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE")
 	w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Accept-Encoding")
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetWriteDeadline(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("stream") != "" {
+			assert.NoError(t, SetWriteDeadline(r.Context(), time.Now().Add(time.Second)))
+		}
+		time.Sleep(150 * time.Millisecond)
+		w.Write([]byte("done")) //nolint:errcheck
+	}))
+	server.Config.WriteTimeout = 50 * time.Millisecond
+	server.Config.ConnContext = ConnContext
+	server.Start()
+	defer server.Close()
+
+	// cut by the write timeout of the server
+	resp, err := http.Get(server.URL)
+	if err == nil {
+		_, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+	}
+	assert.Error(t, err)
+
+	resp, err = http.Get(server.URL + "?stream=1")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "done", string(body))
+}
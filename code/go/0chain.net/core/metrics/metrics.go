@@ -0,0 +1,134 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Number of HTTP requests handled, by handler and status code.",
+	}, []string{"handler", "code"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Latency of HTTP requests, by handler.",
+		Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"handler"})
+
+	httpRequestBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_request_bytes_total",
+		Help: "Bytes read from HTTP request bodies, by handler.",
+	}, []string{"handler"})
+
+	httpResponseBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_response_bytes_total",
+		Help: "Bytes written to HTTP response bodies, by handler.",
+	}, []string{"handler"})
+
+	httpInFlight = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "http_requests_in_flight",
+		Help: "Number of HTTP requests being handled.",
+	})
+
+	workerLoopDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "worker_loop_duration_seconds",
+		Help:    "Duration of a single iteration of a background worker, by worker.",
+		Buckets: []float64{.01, .05, .1, .5, 1, 5, 10, 30, 60, 300},
+	}, []string{"worker"})
+)
+
+/*Handler - the http handler exposing the metrics of the process */
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+/*Middleware - a mux middleware recording the count, latency and body sizes
+* of the requests, labeled with the path template of the matched route */
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := handlerName(r)
+		httpInFlight.Inc()
+		defer httpInFlight.Dec()
+
+		start := time.Now()
+		rw := &responseWriter{ResponseWriter: w, status: http.StatusOK}
+		var body *countingReader
+		if r.Body != nil {
+			body = &countingReader{ReadCloser: r.Body}
+			r.Body = body
+		}
+
+		next.ServeHTTP(rw, r)
+
+		httpRequestDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+		httpRequests.WithLabelValues(name, strconv.Itoa(rw.status)).Inc()
+		httpResponseBytes.WithLabelValues(name).Add(float64(rw.written))
+		if body != nil {
+			httpRequestBytes.WithLabelValues(name).Add(float64(body.read))
+		}
+	})
+}
+
+/*ObserveWorkerLoop - records the duration of a worker iteration started at
+* the given time */
+func ObserveWorkerLoop(worker string, start time.Time) {
+	workerLoopDuration.WithLabelValues(worker).Observe(time.Since(start).Seconds())
+}
+
+func handlerName(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if tpl, err := route.GetPathTemplate(); err == nil {
+			return tpl
+		}
+	}
+	// unmatched paths are not used as labels to keep the cardinality bound
+	return "other"
+}
+
+type responseWriter struct {
+	http.ResponseWriter
+	status      int
+	written     int64
+	wroteHeader bool
+}
+
+func (rw *responseWriter) WriteHeader(status int) {
+	if !rw.wroteHeader {
+		rw.status = status
+		rw.wroteHeader = true
+	}
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+func (rw *responseWriter) Write(p []byte) (int, error) {
+	rw.wroteHeader = true
+	n, err := rw.ResponseWriter.Write(p)
+	rw.written += int64(n)
+	return n, err
+}
+
+func (rw *responseWriter) Flush() {
+	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+type countingReader struct {
+	io.ReadCloser
+	read int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.ReadCloser.Read(p)
+	cr.read += int64(n)
+	return n, err
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	r := mux.NewRouter()
+	r.Use(Middleware)
+	r.HandleFunc("/v1/echo/{allocation}", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+		w.Write(body) //nolint:errcheck
+	})
+	r.Handle("/metrics", Handler())
+
+	const handler = "/v1/echo/{allocation}"
+	req := httptest.NewRequest(http.MethodPost, "/v1/echo/alloc", strings.NewReader("hello"))
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(httpRequests.WithLabelValues(handler, "202")))
+	assert.Equal(t, float64(5), testutil.ToFloat64(httpRequestBytes.WithLabelValues(handler)))
+	assert.Equal(t, float64(5), testutil.ToFloat64(httpResponseBytes.WithLabelValues(handler)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(httpInFlight))
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `http_request_duration_seconds_count{handler="/v1/echo/{allocation}"} 1`)
+}
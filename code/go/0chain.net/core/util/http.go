@@ -31,6 +31,35 @@ func NewHTTPRequest(method string, url string, data []byte) (*http.Request, cont
 	return req, ctx, cncl, err
 }
 
+// SendPostRequestContext posts the data to the url once, within the context,
+// with the hash of the data signed by the node, and returns the body of the
+// response.
+func SendPostRequestContext(ctx context.Context, url string, data []byte) ([]byte, error) {
+	req, _, cncl, err := NewHTTPRequest(http.MethodPost, url, data)
+	cncl()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := node.Self.Sign(req.Header.Get("X-App-Request-Hash"))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(common.ClientSignatureHeader, signature)
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, common.NewError("http_error", "Error from HTTP call. "+string(body))
+	}
+	return body, nil
+}
+
 func SendMultiPostRequest(urls []string, data []byte) {
 	wg := sync.WaitGroup{}
 	wg.Add(len(urls))
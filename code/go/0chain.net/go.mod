@@ -11,10 +11,12 @@ require (
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.3.0
 	github.com/herumi/bls-go-binary v0.0.0-20191119080710-898950e1a520 // indirect
 	github.com/jackc/pgproto3/v2 v2.0.4 // indirect
+	github.com/klauspost/compress v1.11.7
 	github.com/koding/cache v0.0.0-20161222233015-e8a81b0b3f20
 	github.com/minio/minio-go v6.0.14+incompatible
 	github.com/mitchellh/mapstructure v1.3.1
 	github.com/patrickmn/go-cache v2.1.0+incompatible // indirect
+	github.com/prometheus/client_golang v1.9.0
 	github.com/remeh/sizedwaitgroup v0.0.0-20180822144253-5e7302b12cce
 	github.com/spf13/viper v1.7.0
 	github.com/stretchr/testify v1.7.0
@@ -29,6 +31,7 @@ require (
 	gopkg.in/natefinch/lumberjack.v2 v2.0.0
 	gorm.io/datatypes v0.0.0-20200806042100-bc394008dd0d
 	gorm.io/driver/postgres v1.0.0
+	gorm.io/driver/sqlite v1.0.8
 	gorm.io/gorm v1.20.4
 )
 
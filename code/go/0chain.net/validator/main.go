@@ -71,6 +71,7 @@ func main() {
 	config.Configuration.MaxStake = int64(viper.GetFloat64("max_stake") * 1e10)
 	config.Configuration.NumDelegates = viper.GetInt("num_delegates")
 	config.Configuration.ServiceCharge = viper.GetFloat64("service_charge")
+	config.Configuration.TicketStorePath = viper.GetString("ticket_store.path")
 
 	if *hostname == "" {
 		panic("Please specify --hostname which is the public hostname")
@@ -100,6 +101,10 @@ func main() {
 
 	config.SetServerChainID(config.Configuration.ChainID)
 
+	if err := storage.SetupTicketStore(config.Configuration.TicketStorePath); err != nil {
+		Logger.Panic("Error opening the ticket store", zap.Error(err))
+	}
+
 	common.SetupRootContext(node.GetNodeContext())
 	//ctx := common.GetRootContext()
 	serverChain = chain.NewChainFromConfig()
@@ -14,6 +14,7 @@ func SetupDefaultConfig() {
 	viper.SetDefault("min_stake", 1.0)
 	viper.SetDefault("max_stake", 100.0)
 	viper.SetDefault("num_delegates", 100)
+	viper.SetDefault("ticket_store.path", "data/validator_tickets.db")
 }
 
 /*SetupConfig - setup the configuration system */
@@ -45,6 +46,9 @@ type Config struct {
 	NumDelegates int `json:"num_delegates"`
 	// ServiceCharge of related blobber.
 	ServiceCharge float64 `json:"service_charge"`
+	// TicketStorePath is the SQLite database file keeping the validation
+	// tickets issued.
+	TicketStorePath string `json:"ticket_store_path"`
 }
 
 /*Configuration of the system */
@@ -6,20 +6,40 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 
-	"0chain.net/core/cache"
 	"0chain.net/core/common"
+	"0chain.net/core/encryption"
 	. "0chain.net/core/logging"
 	"0chain.net/core/node"
+	"0chain.net/validatorcore/storage/models"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/sha3"
 )
 
-var lru = cache.NewLRUCache(10000)
+var challengeOutcomes = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "validator_challenges_total",
+	Help: "Number of challenge validation requests, by outcome: passed, failed or error.",
+}, []string{"outcome"})
 
 func ChallengeHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	resp, err := validateChallenge(ctx, r)
+	switch vt, _ := resp.(*models.ValidationTicket); {
+	case err != nil || vt == nil:
+		challengeOutcomes.WithLabelValues("error").Inc()
+	case vt.Result:
+		challengeOutcomes.WithLabelValues("passed").Inc()
+	default:
+		challengeOutcomes.WithLabelValues("failed").Inc()
+	}
+	return resp, err
+}
+
+func validateChallenge(ctx context.Context, r *http.Request) (interface{}, error) {
 	if r.Method == "GET" {
 		return nil, common.NewError("invalid_method", "Invalid method used for the upload URL. Use multi-part form POST instead")
 	}
@@ -27,7 +47,7 @@ func ChallengeHandler(ctx context.Context, r *http.Request) (interface{}, error)
 	requestHash := r.Header.Get("X-App-Request-Hash")
 	h := sha3.New256()
 	tReader := io.TeeReader(r.Body, h)
-	var challengeRequest ChallengeRequest
+	var challengeRequest models.ChallengeRequest
 	decoder := json.NewDecoder(tReader)
 	err := decoder.Decode(&challengeRequest)
 	if err != nil {
@@ -47,13 +67,16 @@ func ChallengeHandler(ctx context.Context, r *http.Request) (interface{}, error)
 	}
 
 	Logger.Info("Processing validation.", zap.Any("challenge_id", challengeRequest.ChallengeID))
-	vt, err := lru.Get(challengeHash)
-	retVT, ok := vt.(*ValidationTicket)
-	if vt != nil && err == nil && ok {
-		return retVT, nil
+	issued, err := GetTicketStore().Get(challengeRequest.ChallengeID)
+	if err != nil {
+		return nil, common.NewError("ticket_store_error", "Error getting the issued ticket. "+err.Error())
 	}
-
-	var validationTicket ValidationTicket
+	if issued != nil {
+		// a challenge is validated once, the ticket issued is served again
+		// whatever the request, as it signs the result only
+		return issued.Ticket, nil
+	}
+	var validationTicket models.ValidationTicket
 	challengeObj, err := GetProtocolImpl().VerifyChallengeTransaction(ctx, &challengeRequest)
 	if err != nil {
 		Logger.Error("Error verifying the challenge from BC",
@@ -61,6 +84,13 @@ func ChallengeHandler(ctx context.Context, r *http.Request) (interface{}, error)
 			zap.Error(err))
 		return nil, common.NewError("invalid_parameters", "Challenge could not be verified. "+err.Error())
 	}
+	// the challenge id is public, the result must not be set by another
+	// client sending its own object path first
+	if err = authenticateClient(ctx, r, challengeHash, challengeObj.Blobber.ID); err != nil {
+		Logger.Error("Error authenticating the blobber",
+			zap.Any("challenge_id", challengeRequest.ChallengeID), zap.Error(err))
+		return nil, err
+	}
 
 	time.Sleep(1 * time.Second)
 
@@ -90,7 +120,7 @@ func ChallengeHandler(ctx context.Context, r *http.Request) (interface{}, error)
 		if err := validationTicket.Sign(); err != nil {
 			return nil, common.NewError("invalid_parameters", err.Error())
 		}
-		return &validationTicket, nil
+		return issueTicket(challengeObj.AllocationID, challengeHash, &validationTicket)
 	}
 
 	validationTicket.BlobberID = challengeObj.Blobber.ID
@@ -106,7 +136,68 @@ func ChallengeHandler(ctx context.Context, r *http.Request) (interface{}, error)
 	}
 	Logger.Info("Validation passed.", zap.Any("challenge_id", challengeRequest.ChallengeID))
 
-	lru.Add(challengeHash, &validationTicket) //nolint:errcheck // never returns an error anyway
-	return &validationTicket, nil
+	return issueTicket(challengeObj.AllocationID, challengeHash, &validationTicket)
+}
+
+// authenticateClient checks that the request is signed by the client of its
+// headers, and that the client is the blobber challenged.
+func authenticateClient(ctx context.Context, r *http.Request, requestHash, blobberID string) error {
+	clientID, _ := ctx.Value(CLIENT_CONTEXT_KEY).(string)
+	clientKey, _ := ctx.Value(CLIENT_KEY_CONTEXT_KEY).(string)
+	clientKeyBytes, err := hex.DecodeString(clientKey)
+	if err != nil || len(clientID) == 0 || encryption.Hash(clientKeyBytes) != clientID {
+		return common.NewError("invalid_client", "Call from an invalid client")
+	}
+	if clientID != blobberID {
+		return common.NewError("invalid_client", "Call from a client other than the blobber challenged")
+	}
+	signature := r.Header.Get(common.ClientSignatureHeader)
+	if len(signature) == 0 {
+		return common.NewError("invalid_signature", "Missing signature of the request")
+	}
+	verified, err := encryption.Verify(clientKey, signature, requestHash)
+	if err != nil || !verified {
+		return common.NewError("invalid_signature", "Invalid signature of the request")
+	}
+	return nil
+}
 
+func issueTicket(allocationID, requestHash string, vt *models.ValidationTicket) (*models.ValidationTicket, error) {
+	vt, err := GetTicketStore().Issue(allocationID, requestHash, vt)
+	if err != nil {
+		if _, ok := err.(*common.Error); ok {
+			return nil, err
+		}
+		return nil, common.NewError("ticket_store_error", "Error keeping the issued ticket. "+err.Error())
+	}
+	return vt, nil
+}
+
+// TicketsHandler lists the validation tickets issued for the blobber or the
+// allocation, for auditing.
+func TicketsHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	filter := TicketFilter{
+		BlobberID:    r.FormValue("blobber_id"),
+		AllocationID: r.FormValue("allocation_id"),
+	}
+	if filter.BlobberID == "" && filter.AllocationID == "" {
+		return nil, common.NewError("invalid_parameters", "Missing blobber_id or allocation_id")
+	}
+	if before := r.FormValue("before"); before != "" {
+		var err error
+		if filter.Before, err = time.Parse(time.RFC3339Nano, before); err != nil {
+			return nil, common.NewError("invalid_parameters", "Invalid before: "+err.Error())
+		}
+	}
+	if limit := r.FormValue("limit"); limit != "" {
+		var err error
+		if filter.Limit, err = strconv.Atoi(limit); err != nil {
+			return nil, common.NewError("invalid_parameters", "Invalid limit: "+err.Error())
+		}
+	}
+	issued, err := GetTicketStore().List(filter)
+	if err != nil {
+		return nil, common.NewError("ticket_store_error", "Error listing the issued tickets. "+err.Error())
+	}
+	return issued, nil
 }
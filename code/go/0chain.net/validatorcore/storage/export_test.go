@@ -0,0 +1,3 @@
+package storage
+
+var AuthenticateClient = authenticateClient
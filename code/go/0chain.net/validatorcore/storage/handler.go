@@ -7,13 +7,18 @@ import (
 	"runtime/pprof"
 
 	"0chain.net/core/common"
+	"0chain.net/core/metrics"
 
 	"github.com/gorilla/mux"
 )
 
 /*SetupHandlers sets up the necessary API end points */
 func SetupHandlers(r *mux.Router) {
+	r.Use(metrics.Middleware)
+
 	r.HandleFunc("/v1/storage/challenge/new", common.UserRateLimit(common.ToJSONResponse(SetupContext(ChallengeHandler))))
+	r.HandleFunc("/v1/storage/tickets", common.UserRateLimit(common.ToJSONResponse(TicketsHandler)))
+	r.Handle("/metrics", metrics.Handler())
 	r.HandleFunc("/debug", common.UserRateLimit(common.ToJSONResponse(DumpGoRoutines)))
 }
 
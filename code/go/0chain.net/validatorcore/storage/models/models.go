@@ -1,4 +1,4 @@
-package storage
+package models
 
 import (
 	"encoding/json"
@@ -25,6 +25,11 @@ const (
 	DIRECTORY = "d"
 )
 
+// ErrMerklePathMismatch is the error of a challenged data block not matching
+// the merkle root of its file.
+var ErrMerklePathMismatch = common.NewError("challenge_validation_failed",
+	"Failed to verify the merkle path for the data block")
+
 const LIST_TAG = "list"
 const TYPE_TAG = "type"
 
@@ -329,11 +334,17 @@ func (cr *ChallengeRequest) VerifyChallenge(challengeObj *Challenge, allocationO
 	contentHash := encryption.Hash(cr.DataBlock)
 	merkleVerify := util.VerifyMerklePath(contentHash, cr.MerklePath, cr.ObjPath.Meta.MerkleRoot)
 	if !merkleVerify {
-		return common.NewError("challenge_validation_failed", "Failed to verify the merkle path for the data block")
+		return ErrMerklePathMismatch
 	}
 	return nil
 }
 
+type StorageNode struct {
+	ID        string `json:"id"`
+	BaseURL   string `json:"url"`
+	PublicKey string `json:"-"`
+}
+
 type Challenge struct {
 	ID             string         `json:"id"`
 	Validators     []*StorageNode `json:"validators"`
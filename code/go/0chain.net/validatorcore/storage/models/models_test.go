@@ -1,4 +1,4 @@
-package storage_test
+package models_test
 
 import (
 	"testing"
@@ -7,7 +7,7 @@ import (
 	"0chain.net/core/config"
 	"0chain.net/core/logging"
 	"0chain.net/core/node"
-	"0chain.net/validatorcore/storage"
+	"0chain.net/validatorcore/storage/models"
 
 	"github.com/0chain/gosdk/core/zcncrypto"
 	"github.com/stretchr/testify/assert"
@@ -18,12 +18,12 @@ import (
 func TestAttributes_String(t *testing.T) {
 	tests := []struct {
 		name  string
-		attrs *storage.Attributes
+		attrs *models.Attributes
 		want  string
 	}{
 		{
 			name: "owner",
-			attrs: &storage.Attributes{
+			attrs: &models.Attributes{
 				WhoPaysForReads: common.WhoPaysOwner,
 			},
 			want: "{}",
@@ -31,7 +31,7 @@ func TestAttributes_String(t *testing.T) {
 		},
 		{
 			name: "",
-			attrs: &storage.Attributes{
+			attrs: &models.Attributes{
 				WhoPaysForReads: common.WhoPays3rdParty,
 			},
 			want: "{\"who_pays_for_reads\":1}",
@@ -43,12 +43,12 @@ func TestAttributes_String(t *testing.T) {
 		},
 		{
 			name:  "empty",
-			attrs: &storage.Attributes{},
+			attrs: &models.Attributes{},
 			want:  "{}",
 		},
 		{
 			name: "invalid",
-			attrs: &storage.Attributes{
+			attrs: &models.Attributes{
 				WhoPaysForReads: 2,
 			},
 			want: "{\"who_pays_for_reads\":2}",
@@ -74,22 +74,22 @@ func TestDirMetaData_GetHash(t *testing.T) {
 func TestDirMetaData_CalculateHash(t *testing.T) {
 	tests := []struct {
 		name string
-		dmd  storage.DirMetaData
+		dmd  models.DirMetaData
 		want string
 	}{
 		{
 			name: "without children",
-			dmd: storage.DirMetaData{
+			dmd: models.DirMetaData{
 				Hash: "hash0",
 			},
 			want: "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
 		},
 		{
 			name: "with children",
-			dmd: storage.DirMetaData{
+			dmd: models.DirMetaData{
 				Hash: "hash0",
-				Children: []storage.ObjectEntity{
-					&storage.DirMetaData{
+				Children: []models.ObjectEntity{
+					&models.DirMetaData{
 						Hash: "hash1",
 					},
 				},
@@ -98,13 +98,13 @@ func TestDirMetaData_CalculateHash(t *testing.T) {
 		},
 		{
 			name: "with nested children",
-			dmd: storage.DirMetaData{
+			dmd: models.DirMetaData{
 				Hash: "hash0",
-				Children: []storage.ObjectEntity{
-					&storage.DirMetaData{
+				Children: []models.ObjectEntity{
+					&models.DirMetaData{
 						Hash: "hash1",
-						Children: []storage.ObjectEntity{
-							&storage.DirMetaData{
+						Children: []models.ObjectEntity{
+							&models.DirMetaData{
 								Hash: "hash2",
 							},
 						},
@@ -130,14 +130,14 @@ func TestDirMetaData_GetType(t *testing.T) {
 func TestFileMetaData_GetHashData(t *testing.T) {
 	tests := []struct {
 		name string
-		fmd  storage.FileMetaData
+		fmd  models.FileMetaData
 		want string
 	}{
 		{
 			name: "with Attributes.WhoPays = WhoPaysOwner",
-			fmd: storage.FileMetaData{
-				DirMetaData: storage.DirMetaData{},
-				Attributes: storage.Attributes{
+			fmd: models.FileMetaData{
+				DirMetaData: models.DirMetaData{},
+				Attributes: models.Attributes{
 					WhoPaysForReads: common.WhoPaysOwner,
 				},
 			},
@@ -146,9 +146,9 @@ func TestFileMetaData_GetHashData(t *testing.T) {
 		},
 		{
 			name: "with Attributes.WhoPays = WhoPays3rdParty",
-			fmd: storage.FileMetaData{
-				DirMetaData: storage.DirMetaData{},
-				Attributes: storage.Attributes{
+			fmd: models.FileMetaData{
+				DirMetaData: models.DirMetaData{},
+				Attributes: models.Attributes{
 					WhoPaysForReads: common.WhoPays3rdParty,
 				},
 			},
@@ -156,8 +156,8 @@ func TestFileMetaData_GetHashData(t *testing.T) {
 		},
 		{
 			name: "with Attributes.WhoPays = nil",
-			fmd: storage.FileMetaData{
-				DirMetaData: storage.DirMetaData{},
+			fmd: models.FileMetaData{
+				DirMetaData: models.DirMetaData{},
 			},
 			want: "::::0:::0::{}",
 		},
@@ -182,13 +182,13 @@ func TestFileMetaData_GetHash(t *testing.T) {
 func TestFileMetaData_CalculateHash(t *testing.T) {
 	tests := []struct {
 		name string
-		fmd  storage.FileMetaData
+		fmd  models.FileMetaData
 		want string
 	}{
 		{
 			name: "with Attributes.WhoPays = WhoPaysOwner",
-			fmd: storage.FileMetaData{
-				Attributes: storage.Attributes{
+			fmd: models.FileMetaData{
+				Attributes: models.Attributes{
 					WhoPaysForReads: common.WhoPaysOwner,
 				},
 			},
@@ -197,8 +197,8 @@ func TestFileMetaData_CalculateHash(t *testing.T) {
 		},
 		{
 			name: "with Attributes.WhoPays = WhoPays3rdParty",
-			fmd: storage.FileMetaData{
-				Attributes: storage.Attributes{
+			fmd: models.FileMetaData{
+				Attributes: models.Attributes{
 					WhoPaysForReads: common.WhoPays3rdParty,
 				},
 			},
@@ -206,7 +206,7 @@ func TestFileMetaData_CalculateHash(t *testing.T) {
 		},
 		{
 			name: "with Attributes.WhoPays = nil",
-			fmd:  storage.FileMetaData{},
+			fmd:  models.FileMetaData{},
 			want: "f78718c8ad33d8b97fe902dabc36df401f82c88bde608ab85005d332ac24de43",
 		},
 	}
@@ -228,16 +228,16 @@ func TestObjectPath_Parse(t *testing.T) {
 
 	tests := []struct {
 		name       string
-		objPath    *storage.ObjectPath
+		objPath    *models.ObjectPath
 		input      map[string]interface{}
 		allocID    string
-		want       *storage.DirMetaData
+		want       *models.DirMetaData
 		wantErr    bool
 		wantErrMsg string
 	}{
 		{
 			name:    "dir/file path: hash mismatch",
-			objPath: &storage.ObjectPath{},
+			objPath: &models.ObjectPath{},
 			input: map[string]interface{}{
 				"path": "dir1",
 				"hash": "b25a7f67d4206d77fca08a48a06eba893c59077ea61435f71b31d098ea2f7991",
@@ -256,7 +256,7 @@ func TestObjectPath_Parse(t *testing.T) {
 		},
 		{
 			name:    "dir/dir/file path: hash mismatch",
-			objPath: &storage.ObjectPath{},
+			objPath: &models.ObjectPath{},
 			input: map[string]interface{}{
 				"path": "dir1",
 				"hash": "a02b02080606e78e165fe5a42f8b0087ff82617a1f9c26cc95e269fd653c5a72",
@@ -299,15 +299,15 @@ func TestObjectPath_VerifyBlockNum(t *testing.T) {
 	logging.Logger = zap.New(nil) // FIXME to avoid complains
 	tests := []struct {
 		name       string
-		objPath    *storage.ObjectPath
+		objPath    *models.ObjectPath
 		rand       int64
 		wantErr    bool
 		wantErrMsg string
 	}{
 		{
 			name: "0",
-			objPath: &storage.ObjectPath{
-				RootObject: &storage.DirMetaData{
+			objPath: &models.ObjectPath{
+				RootObject: &models.DirMetaData{
 					NumBlocks: int64(0),
 				},
 			},
@@ -315,8 +315,8 @@ func TestObjectPath_VerifyBlockNum(t *testing.T) {
 		},
 		{
 			name: "not found",
-			objPath: &storage.ObjectPath{
-				RootObject: &storage.DirMetaData{
+			objPath: &models.ObjectPath{
+				RootObject: &models.DirMetaData{
 					NumBlocks: int64(1),
 				},
 			},
@@ -326,12 +326,12 @@ func TestObjectPath_VerifyBlockNum(t *testing.T) {
 		},
 		{
 			name: "not found with children",
-			objPath: &storage.ObjectPath{
-				RootObject: &storage.DirMetaData{
+			objPath: &models.ObjectPath{
+				RootObject: &models.DirMetaData{
 					NumBlocks: int64(1),
-					Children: []storage.ObjectEntity{
-						&storage.DirMetaData{
-							Type:      storage.DIRECTORY,
+					Children: []models.ObjectEntity{
+						&models.DirMetaData{
+							Type:      models.DIRECTORY,
 							NumBlocks: int64(1),
 						},
 					},
@@ -343,20 +343,20 @@ func TestObjectPath_VerifyBlockNum(t *testing.T) {
 		},
 		{
 			name: "found wrong hash",
-			objPath: &storage.ObjectPath{
-				RootObject: &storage.DirMetaData{
+			objPath: &models.ObjectPath{
+				RootObject: &models.DirMetaData{
 					NumBlocks: int64(1),
-					Children: []storage.ObjectEntity{
-						&storage.FileMetaData{
-							DirMetaData: storage.DirMetaData{
-								Type:      storage.FILE,
+					Children: []models.ObjectEntity{
+						&models.FileMetaData{
+							DirMetaData: models.DirMetaData{
+								Type:      models.FILE,
 								NumBlocks: int64(1),
 							},
 						},
 					},
 				},
-				Meta: &storage.FileMetaData{
-					DirMetaData: storage.DirMetaData{
+				Meta: &models.FileMetaData{
+					DirMetaData: models.DirMetaData{
 						Hash: "hash",
 					},
 				},
@@ -384,26 +384,26 @@ func TestObjectPath_VerifyPath(t *testing.T) {
 
 	tests := []struct {
 		name       string
-		objPath    *storage.ObjectPath
+		objPath    *models.ObjectPath
 		allocID    string
 		wantErr    bool
 		wantErrMsg string
 	}{
 		{
 			name:       "invalid input",
-			objPath:    &storage.ObjectPath{},
+			objPath:    &models.ObjectPath{},
 			allocID:    "1",
 			wantErr:    true,
 			wantErrMsg: "Object path error since there is a mismatch in the dir hashes.",
 		},
 		{
 			name: "empty",
-			objPath: &storage.ObjectPath{
+			objPath: &models.ObjectPath{
 				RootHash: "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
 				Path: map[string]interface{}{
 					"hash": "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
 				},
-				RootObject: &storage.DirMetaData{
+				RootObject: &models.DirMetaData{
 					CreationDate: common.Timestamp(0),
 					Type:         "",
 					Name:         "",
@@ -419,16 +419,16 @@ func TestObjectPath_VerifyPath(t *testing.T) {
 		},
 		{
 			name: "root",
-			objPath: &storage.ObjectPath{
+			objPath: &models.ObjectPath{
 				RootHash: "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
 				Path: map[string]interface{}{
 					"path": "file.txt",
 					"hash": "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
 					"type": "f",
 				},
-				RootObject: &storage.DirMetaData{
+				RootObject: &models.DirMetaData{
 					CreationDate: common.Timestamp(0),
-					Type:         storage.FILE,
+					Type:         models.FILE,
 					Name:         "",
 					Path:         "file.txt",
 					Hash:         "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
@@ -442,7 +442,7 @@ func TestObjectPath_VerifyPath(t *testing.T) {
 		},
 		{
 			name: "dir/file",
-			objPath: &storage.ObjectPath{
+			objPath: &models.ObjectPath{
 				RootHash: "b25a7f67d4206d77fca08a48a06eba893c59077ea61435f71b31d098ea2f7991",
 				Path: map[string]interface{}{
 					"path": "dir1",
@@ -456,20 +456,20 @@ func TestObjectPath_VerifyPath(t *testing.T) {
 						},
 					},
 				},
-				RootObject: &storage.DirMetaData{
+				RootObject: &models.DirMetaData{
 					CreationDate: common.Timestamp(0),
-					Type:         storage.DIRECTORY,
+					Type:         models.DIRECTORY,
 					Name:         "",
 					Path:         "dir1",
 					Hash:         "b25a7f67d4206d77fca08a48a06eba893c59077ea61435f71b31d098ea2f7991",
 					PathHash:     "",
 					NumBlocks:    int64(0),
 					AllocationID: "",
-					Children: []storage.ObjectEntity{
-						&storage.FileMetaData{
-							DirMetaData: storage.DirMetaData{
+					Children: []models.ObjectEntity{
+						&models.FileMetaData{
+							DirMetaData: models.DirMetaData{
 								CreationDate: common.Timestamp(0),
-								Type:         storage.FILE,
+								Type:         models.FILE,
 								Name:         "",
 								Path:         "file.txt",
 								Hash:         "87177591985fdf5c010d7781f0dc82b5d3c40b6bf8892b3c69000eb000f1e33a",
@@ -484,7 +484,7 @@ func TestObjectPath_VerifyPath(t *testing.T) {
 							MerkleRoot:     "",
 							ActualFileSize: int64(0),
 							ActualFileHash: "",
-							Attributes:     storage.Attributes{},
+							Attributes:     models.Attributes{},
 						},
 					},
 				},
@@ -493,7 +493,7 @@ func TestObjectPath_VerifyPath(t *testing.T) {
 		},
 		{
 			name: "dir/file path: hash mismatch",
-			objPath: &storage.ObjectPath{
+			objPath: &models.ObjectPath{
 				RootHash: "87177591985fdf5c010d7781f0dc82b5d3c40b6bf8892b3c69000eb000f1e33a",
 				Path: map[string]interface{}{
 					"path": "dir1",
@@ -507,20 +507,20 @@ func TestObjectPath_VerifyPath(t *testing.T) {
 						},
 					},
 				},
-				RootObject: &storage.DirMetaData{
+				RootObject: &models.DirMetaData{
 					CreationDate: common.Timestamp(0),
-					Type:         storage.DIRECTORY,
+					Type:         models.DIRECTORY,
 					Name:         "",
 					Path:         "dir1",
 					Hash:         "b25a7f67d4206d77fca08a48a06eba893c59077ea61435f71b31d098ea2f7991",
 					PathHash:     "",
 					NumBlocks:    int64(0),
 					AllocationID: "",
-					Children: []storage.ObjectEntity{
-						&storage.FileMetaData{
-							DirMetaData: storage.DirMetaData{
+					Children: []models.ObjectEntity{
+						&models.FileMetaData{
+							DirMetaData: models.DirMetaData{
 								CreationDate: common.Timestamp(0),
-								Type:         storage.FILE,
+								Type:         models.FILE,
 								Name:         "",
 								Path:         "file.txt",
 								Hash:         "87177591985fdf5c010d7781f0dc82b5d3c40b6bf8892b3c69000eb000f1e33a",
@@ -535,7 +535,7 @@ func TestObjectPath_VerifyPath(t *testing.T) {
 							MerkleRoot:     "",
 							ActualFileSize: int64(0),
 							ActualFileHash: "",
-							Attributes:     storage.Attributes{},
+							Attributes:     models.Attributes{},
 						},
 					},
 				},
@@ -546,7 +546,7 @@ func TestObjectPath_VerifyPath(t *testing.T) {
 		},
 		{
 			name: "dir/dir/file",
-			objPath: &storage.ObjectPath{
+			objPath: &models.ObjectPath{
 				RootHash: "a02b02080606e78e165fe5a42f8b0087ff82617a1f9c26cc95e269fd653c5a72",
 				Path: map[string]interface{}{
 					"path": "dir1",
@@ -567,30 +567,30 @@ func TestObjectPath_VerifyPath(t *testing.T) {
 						},
 					},
 				},
-				RootObject: &storage.DirMetaData{
+				RootObject: &models.DirMetaData{
 					CreationDate: common.Timestamp(0),
-					Type:         storage.DIRECTORY,
+					Type:         models.DIRECTORY,
 					Name:         "",
 					Path:         "dir1",
 					Hash:         "a02b02080606e78e165fe5a42f8b0087ff82617a1f9c26cc95e269fd653c5a72",
 					PathHash:     "",
 					NumBlocks:    int64(0),
 					AllocationID: "",
-					Children: []storage.ObjectEntity{
-						&storage.DirMetaData{
+					Children: []models.ObjectEntity{
+						&models.DirMetaData{
 							CreationDate: common.Timestamp(0),
-							Type:         storage.DIRECTORY,
+							Type:         models.DIRECTORY,
 							Name:         "",
 							Path:         "dir2",
 							Hash:         "b25a7f67d4206d77fca08a48a06eba893c59077ea61435f71b31d098ea2f7991",
 							PathHash:     "",
 							NumBlocks:    int64(0),
 							AllocationID: "1",
-							Children: []storage.ObjectEntity{
-								&storage.FileMetaData{
-									DirMetaData: storage.DirMetaData{
+							Children: []models.ObjectEntity{
+								&models.FileMetaData{
+									DirMetaData: models.DirMetaData{
 										CreationDate: common.Timestamp(0),
-										Type:         storage.FILE,
+										Type:         models.FILE,
 										Name:         "",
 										Path:         "file.txt",
 										Hash:         "87177591985fdf5c010d7781f0dc82b5d3c40b6bf8892b3c69000eb000f1e33a",
@@ -605,7 +605,7 @@ func TestObjectPath_VerifyPath(t *testing.T) {
 									MerkleRoot:     "",
 									ActualFileSize: int64(0),
 									ActualFileHash: "",
-									Attributes:     storage.Attributes{},
+									Attributes:     models.Attributes{},
 								},
 							},
 						},
@@ -638,25 +638,25 @@ func TestChallengeRequest_VerifyChallenge(t *testing.T) {
 
 	tests := []struct {
 		name       string
-		chReq      *storage.ChallengeRequest
-		ch         *storage.Challenge
-		alloc      *storage.Allocation
+		chReq      *models.ChallengeRequest
+		ch         *models.Challenge
+		alloc      *models.Allocation
 		wantErr    bool
 		wantErrMsg string
 	}{
 		{
 			name: "verify object path fails",
-			chReq: &storage.ChallengeRequest{
-				ObjPath: &storage.ObjectPath{
+			chReq: &models.ChallengeRequest{
+				ObjPath: &models.ObjectPath{
 					RootHash: "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
 					Path: map[string]interface{}{
 						"path": "file.txt",
 						"hash": "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
 						"type": "f",
 					},
-					RootObject: &storage.DirMetaData{
+					RootObject: &models.DirMetaData{
 						CreationDate: common.Timestamp(0),
-						Type:         storage.FILE,
+						Type:         models.FILE,
 						Name:         "",
 						Path:         "file.txt",
 						Hash:         "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
@@ -667,7 +667,7 @@ func TestChallengeRequest_VerifyChallenge(t *testing.T) {
 					},
 				},
 			},
-			ch: &storage.Challenge{
+			ch: &models.Challenge{
 				RandomNumber: int64(1),
 				AllocationID: "2",
 			},
@@ -676,17 +676,17 @@ func TestChallengeRequest_VerifyChallenge(t *testing.T) {
 		},
 		{
 			name: "invalid write marker",
-			chReq: &storage.ChallengeRequest{
-				ObjPath: &storage.ObjectPath{
+			chReq: &models.ChallengeRequest{
+				ObjPath: &models.ObjectPath{
 					RootHash: "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
 					Path: map[string]interface{}{
 						"path": "file.txt",
 						"hash": "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
 						"type": "f",
 					},
-					RootObject: &storage.DirMetaData{
+					RootObject: &models.DirMetaData{
 						CreationDate: common.Timestamp(0),
-						Type:         storage.FILE,
+						Type:         models.FILE,
 						Name:         "",
 						Path:         "file.txt",
 						Hash:         "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
@@ -697,7 +697,7 @@ func TestChallengeRequest_VerifyChallenge(t *testing.T) {
 					},
 				},
 			},
-			ch: &storage.Challenge{
+			ch: &models.Challenge{
 				RandomNumber: int64(1),
 				AllocationID: "1",
 			},
@@ -722,7 +722,7 @@ func TestValidationTicket_Sign(t *testing.T) {
 	err := setupModelsTest(t)
 	require.NoError(t, err)
 
-	vt := storage.ValidationTicket{
+	vt := models.ValidationTicket{
 		ChallengeID:  "challenge_id",
 		BlobberID:    "blobber_id",
 		ValidatorID:  "validator_id",
@@ -12,6 +12,7 @@ import (
 	"0chain.net/core/node"
 	"0chain.net/core/transaction"
 	"0chain.net/validatorcore/config"
+	"0chain.net/validatorcore/storage/models"
 
 	"github.com/0chain/gosdk/zcncore"
 	"go.uber.org/zap"
@@ -22,12 +23,6 @@ const ALLOCATION_CONTEXT_KEY common.ContextKey = "allocation"
 const CLIENT_CONTEXT_KEY common.ContextKey = "client"
 const CLIENT_KEY_CONTEXT_KEY common.ContextKey = "client_key"
 
-type StorageNode struct {
-	ID        string `json:"id"`
-	BaseURL   string `json:"url"`
-	PublicKey string `json:"-"`
-}
-
 //ValidatorProtocolImpl - implementation of the storage protocol
 type ValidatorProtocolImpl struct {
 	ServerChain *chain.Chain
@@ -70,12 +65,12 @@ func GetProtocolImpl() *ValidatorProtocolImpl {
 // 	return txn.Hash, nil
 // }
 
-func (sp *ValidatorProtocolImpl) VerifyAllocationTransaction(ctx context.Context, allocationID string) (*Allocation, error) {
+func (sp *ValidatorProtocolImpl) VerifyAllocationTransaction(ctx context.Context, allocationID string) (*models.Allocation, error) {
 	t, err := transaction.VerifyTransaction(allocationID, sp.ServerChain)
 	if err != nil {
 		return nil, common.NewError("invalid_allocation", "Invalid Allocation id. Allocation not found in blockchain. "+err.Error())
 	}
-	var allocationObj Allocation
+	var allocationObj models.Allocation
 	err = json.Unmarshal([]byte(t.TransactionOutput), &allocationObj)
 	if err != nil {
 		return nil, common.NewError("transaction_output_decode_error", "Error decoding the allocation transaction output."+err.Error())
@@ -83,7 +78,7 @@ func (sp *ValidatorProtocolImpl) VerifyAllocationTransaction(ctx context.Context
 	return &allocationObj, nil
 }
 
-func (sp *ValidatorProtocolImpl) VerifyChallengeTransaction(ctx context.Context, challengeRequest *ChallengeRequest) (*Challenge, error) {
+func (sp *ValidatorProtocolImpl) VerifyChallengeTransaction(ctx context.Context, challengeRequest *models.ChallengeRequest) (*models.Challenge, error) {
 	blobberID := ctx.Value(CLIENT_CONTEXT_KEY).(string)
 	if len(blobberID) == 0 {
 		return nil, common.NewError("invalid_client", "Call from an invalid client")
@@ -96,7 +91,7 @@ func (sp *ValidatorProtocolImpl) VerifyChallengeTransaction(ctx context.Context,
 	if err != nil {
 		return nil, common.NewError("invalid_challenge", "Invalid challenge id. Challenge not found in blockchain. "+err.Error())
 	}
-	var challengeObj Challenge
+	var challengeObj models.Challenge
 	err = json.Unmarshal(challengeBytes, &challengeObj)
 	if err != nil {
 		return nil, common.NewError("transaction_output_decode_error", "Error decoding the challenge output."+err.Error())
@@ -0,0 +1,208 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"0chain.net/validatorcore/storage/models"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// DefaultTicketLimit is the number of tickets of a page of the listing
+	// when the request does not set it.
+	DefaultTicketLimit = 100
+	// MaxTicketLimit is the max number of tickets of a page of the listing.
+	MaxTicketLimit = 1000
+)
+
+const createIssuedTickets = `
+CREATE TABLE IF NOT EXISTS issued_tickets (
+    challenge_id VARCHAR(64) NOT NULL,
+    blobber_id VARCHAR(64) NOT NULL,
+    allocation_id VARCHAR(64) NOT NULL,
+    request_hash VARCHAR(64) NOT NULL,
+    ticket TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (challenge_id, blobber_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_issued_tickets_blobber ON issued_tickets (blobber_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_issued_tickets_allocation ON issued_tickets (allocation_id, created_at);
+`
+
+// IssuedTicket is a validation ticket issued for a challenge, with the hash
+// of the request it was issued for.
+type IssuedTicket struct {
+	ChallengeID  string                   `gorm:"column:challenge_id;primary_key" json:"challenge_id"`
+	BlobberID    string                   `gorm:"column:blobber_id;primary_key" json:"blobber_id"`
+	AllocationID string                   `gorm:"column:allocation_id" json:"allocation_id"`
+	RequestHash  string                   `gorm:"column:request_hash" json:"request_hash"`
+	TicketJSON   string                   `gorm:"column:ticket" json:"-"`
+	Ticket       *models.ValidationTicket `gorm:"-" json:"ticket"`
+	CreatedAt    time.Time                `gorm:"column:created_at" json:"created_at"`
+}
+
+func (IssuedTicket) TableName() string {
+	return "issued_tickets"
+}
+
+func (it *IssuedTicket) decode() error {
+	it.Ticket = new(models.ValidationTicket)
+	return json.Unmarshal([]byte(it.TicketJSON), it.Ticket)
+}
+
+// TicketStore keeps the validation tickets issued, so that a challenge is
+// validated once, whatever the restarts of the validator.
+type TicketStore struct {
+	db *gorm.DB
+}
+
+var tickets *TicketStore
+
+// SetupTicketStore opens the ticket store of the validator in the SQLite
+// database file at the path, ":memory:" for a private in-memory one.
+func SetupTicketStore(path string) error {
+	store, err := OpenTicketStore(path)
+	if err != nil {
+		return err
+	}
+	tickets = store
+	return nil
+}
+
+// GetTicketStore returns the ticket store of the validator.
+func GetTicketStore() *TicketStore {
+	return tickets
+}
+
+// OpenTicketStore opens a ticket store in the SQLite database file at the
+// path, ":memory:" for a private in-memory one.
+func OpenTicketStore(path string) (*TicketStore, error) {
+	var inMemory = path == "" || path == ":memory:"
+	var dsn = path
+	if inMemory {
+		dsn = ":memory:"
+	}
+	dsn += "?_busy_timeout=10000"
+	if !inMemory {
+		dsn += "&_journal_mode=WAL"
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	if inMemory {
+		sqldb, err := db.DB()
+		if err != nil {
+			return nil, err
+		}
+		// every connection would get its own empty database
+		sqldb.SetMaxOpenConns(1)
+	}
+	if err = db.Exec(createIssuedTickets).Error; err != nil {
+		return nil, err
+	}
+	return &TicketStore{db: db}, nil
+}
+
+// Close closes the database of the store.
+func (ts *TicketStore) Close() {
+	if sqldb, err := ts.db.DB(); err == nil {
+		sqldb.Close()
+	}
+}
+
+// Get returns the ticket issued for the challenge, nil if none is.
+func (ts *TicketStore) Get(challengeID string) (*IssuedTicket, error) {
+	var issued []*IssuedTicket
+	err := ts.db.Where("challenge_id = ?", challengeID).Limit(1).
+		Find(&issued).Error
+	if err != nil || len(issued) == 0 {
+		return nil, err
+	}
+	return issued[0], issued[0].decode()
+}
+
+// Issue keeps the ticket issued for the challenge of the allocation, for the
+// request of the hash. If a ticket was already issued for the challenge and
+// the blobber, the one kept is returned instead.
+func (ts *TicketStore) Issue(allocationID, requestHash string,
+	vt *models.ValidationTicket) (*models.ValidationTicket, error) {
+
+	ticketJSON, err := json.Marshal(vt)
+	if err != nil {
+		return nil, err
+	}
+	issued := &IssuedTicket{
+		ChallengeID:  vt.ChallengeID,
+		BlobberID:    vt.BlobberID,
+		AllocationID: allocationID,
+		RequestHash:  requestHash,
+		TicketJSON:   string(ticketJSON),
+		CreatedAt:    time.Now().UTC(),
+	}
+	res := ts.db.Clauses(clause.OnConflict{DoNothing: true}).Create(issued)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 1 {
+		return vt, nil
+	}
+
+	// issued concurrently
+	if issued, err = ts.Get(vt.ChallengeID); err != nil {
+		return nil, err
+	}
+	return issued.Ticket, nil
+}
+
+// TicketFilter selects the tickets of the listing.
+type TicketFilter struct {
+	BlobberID    string
+	AllocationID string
+	// Before is the time the tickets were issued before, the one of the
+	// last ticket of the previous page, zero for the latest ones.
+	Before time.Time
+	Limit  int
+}
+
+// List returns the tickets selected by the filter, the latest first.
+func (ts *TicketStore) List(filter TicketFilter) ([]*IssuedTicket, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultTicketLimit
+	} else if limit > MaxTicketLimit {
+		limit = MaxTicketLimit
+	}
+	db := ts.db.Model(&IssuedTicket{})
+	if filter.BlobberID != "" {
+		db = db.Where("blobber_id = ?", filter.BlobberID)
+	}
+	if filter.AllocationID != "" {
+		db = db.Where("allocation_id = ?", filter.AllocationID)
+	}
+	if !filter.Before.IsZero() {
+		db = db.Where("created_at < ?", filter.Before.UTC())
+	}
+	issued := make([]*IssuedTicket, 0)
+	err := db.Order("created_at DESC").Limit(limit).Find(&issued).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, it := range issued {
+		if err = it.decode(); err != nil {
+			return nil, err
+		}
+	}
+	return issued, nil
+}
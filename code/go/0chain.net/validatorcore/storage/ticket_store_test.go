@@ -0,0 +1,198 @@
+package storage_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"0chain.net/core/common"
+	"0chain.net/core/config"
+	"0chain.net/core/encryption"
+	"0chain.net/core/logging"
+	"0chain.net/validatorcore/storage"
+	"0chain.net/validatorcore/storage/models"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/sha3"
+)
+
+func setupTicketStore(t *testing.T) func() {
+	logging.Logger = zap.NewNop()
+	require.NoError(t, storage.SetupTicketStore(":memory:"))
+	return storage.GetTicketStore().Close
+}
+
+func issueTicket(t *testing.T, challengeID, blobberID, allocationID, requestHash string) *models.ValidationTicket {
+	vt, err := storage.GetTicketStore().Issue(allocationID, requestHash,
+		&models.ValidationTicket{ChallengeID: challengeID, BlobberID: blobberID, Result: true})
+	require.NoError(t, err)
+	return vt
+}
+
+func TestTicketStore_Issue(t *testing.T) {
+	defer setupTicketStore(t)()
+	store := storage.GetTicketStore()
+
+	issued, err := store.Get("challenge")
+	require.NoError(t, err)
+	assert.Nil(t, issued)
+
+	issueTicket(t, "challenge", "blobber", "alloc", "hash")
+	issued, err = store.Get("challenge")
+	require.NoError(t, err)
+	require.NotNil(t, issued)
+	assert.Equal(t, "alloc", issued.AllocationID)
+	assert.Equal(t, "hash", issued.RequestHash)
+	assert.True(t, issued.Ticket.Result)
+
+	// the same request gets the ticket already issued
+	vt, err := store.Issue("alloc", "hash", &models.ValidationTicket{
+		ChallengeID: "challenge", BlobberID: "blobber", Result: false})
+	require.NoError(t, err)
+	assert.True(t, vt.Result)
+
+	// and so does another one, the first result is kept
+	vt, err = store.Issue("alloc", "other", &models.ValidationTicket{
+		ChallengeID: "challenge", BlobberID: "blobber", Result: false})
+	require.NoError(t, err)
+	assert.True(t, vt.Result)
+}
+
+func TestTicketStore_List(t *testing.T) {
+	defer setupTicketStore(t)()
+	store := storage.GetTicketStore()
+
+	issueTicket(t, "c1", "blobber1", "alloc1", "h1")
+	issueTicket(t, "c2", "blobber1", "alloc2", "h2")
+	issueTicket(t, "c3", "blobber2", "alloc1", "h3")
+
+	issued, err := store.List(storage.TicketFilter{BlobberID: "blobber1"})
+	require.NoError(t, err)
+	require.Len(t, issued, 2)
+	assert.Equal(t, "c2", issued[0].ChallengeID)
+	assert.Equal(t, "c1", issued[1].ChallengeID)
+
+	issued, err = store.List(storage.TicketFilter{AllocationID: "alloc1", Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, issued, 1)
+	assert.Equal(t, "c3", issued[0].ChallengeID)
+	assert.Equal(t, "c3", issued[0].Ticket.ChallengeID)
+
+	issued, err = store.List(storage.TicketFilter{AllocationID: "alloc1",
+		Before: issued[0].CreatedAt.In(time.FixedZone("", 3600))})
+	require.NoError(t, err)
+	require.Len(t, issued, 1)
+	assert.Equal(t, "c1", issued[0].ChallengeID)
+}
+
+func challengeRequest(t *testing.T, body string) *http.Request {
+	req, err := http.NewRequest("POST", "url", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	h := sha3.New256()
+	h.Write([]byte(body))
+	req.Header.Set("X-App-Request-Hash", hex.EncodeToString(h.Sum(nil)))
+	return req
+}
+
+func TestChallengeHandler_Replay(t *testing.T) {
+	defer setupTicketStore(t)()
+
+	body := `{"challenge_id":"challenge","object_path":{}}`
+	req := challengeRequest(t, body)
+	issueTicket(t, "challenge", "blobber", "alloc", req.Header.Get("X-App-Request-Hash"))
+
+	got, err := storage.ChallengeHandler(context.TODO(), req)
+	require.NoError(t, err)
+	vt, ok := got.(*models.ValidationTicket)
+	require.True(t, ok)
+	assert.Equal(t, "challenge", vt.ChallengeID)
+	assert.Equal(t, "blobber", vt.BlobberID)
+
+	// a retry of the blobber with another request gets the same ticket
+	req = challengeRequest(t, `{"challenge_id":"challenge","object_path":{"root_hash":"other"}}`)
+	got, err = storage.ChallengeHandler(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Equal(t, vt, got)
+}
+
+func TestAuthenticateClient(t *testing.T) {
+	prevScheme := config.Configuration.SignatureScheme
+	defer func() { config.Configuration.SignatureScheme = prevScheme }()
+	config.Configuration.SignatureScheme = "bls0chain"
+
+	newClient := func() (zcncrypto.SignatureScheme, string) {
+		scheme := zcncrypto.NewSignatureScheme("bls0chain")
+		_, err := scheme.GenerateKeys()
+		require.NoError(t, err)
+		keyBytes, err := hex.DecodeString(scheme.GetPublicKey())
+		require.NoError(t, err)
+		return scheme, encryption.Hash(keyBytes)
+	}
+	blobber, blobberID := newClient()
+	other, otherID := newClient()
+
+	body := `{"challenge_id":"challenge","object_path":{}}`
+	tests := []struct {
+		name       string
+		client     zcncrypto.SignatureScheme
+		clientID   string
+		signedBody string
+		wantErrMsg string
+	}{
+		{name: "signed by the blobber", client: blobber, clientID: blobberID, signedBody: body},
+		{name: "unsigned", client: blobber, clientID: blobberID, wantErrMsg: "invalid_signature"},
+		{name: "signature of another request", client: blobber, clientID: blobberID,
+			signedBody: "other", wantErrMsg: "invalid_signature"},
+		{name: "key of another client", client: blobber, clientID: "other",
+			signedBody: body, wantErrMsg: "invalid_client"},
+		{name: "signed by another client", client: other, clientID: otherID,
+			signedBody: body, wantErrMsg: "invalid_client"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := challengeRequest(t, body)
+			requestHash := req.Header.Get("X-App-Request-Hash")
+			if tt.signedBody != "" {
+				signature, err := tt.client.Sign(encryption.Hash(tt.signedBody))
+				require.NoError(t, err)
+				req.Header.Set(common.ClientSignatureHeader, signature)
+			}
+			req.Header.Set(common.ClientHeader, tt.clientID)
+			req.Header.Set(common.ClientKeyHeader, tt.client.GetPublicKey())
+			_, err := storage.SetupContext(func(ctx context.Context, r *http.Request) (interface{}, error) {
+				return nil, storage.AuthenticateClient(ctx, r, requestHash, blobberID)
+			})(context.TODO(), req)
+			if tt.wantErrMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErrMsg)
+		})
+	}
+}
+
+func TestTicketsHandler(t *testing.T) {
+	defer setupTicketStore(t)()
+	issueTicket(t, "c1", "blobber", "alloc", "h1")
+
+	req, err := http.NewRequest("GET", "/v1/storage/tickets", nil)
+	require.NoError(t, err)
+	_, err = storage.TicketsHandler(context.TODO(), req)
+	require.Error(t, err)
+
+	req, err = http.NewRequest("GET", "/v1/storage/tickets?blobber_id=blobber", nil)
+	require.NoError(t, err)
+	got, err := storage.TicketsHandler(context.TODO(), req)
+	require.NoError(t, err)
+	issued, ok := got.([]*storage.IssuedTicket)
+	require.True(t, ok)
+	require.Len(t, issued, 1)
+	assert.Equal(t, "c1", issued[0].ChallengeID)
+}
@@ -2,4 +2,14 @@ CREATE extension ltree;
 CREATE DATABASE blobber_meta;
 \connect blobber_meta;
 CREATE USER blobber_user WITH ENCRYPTED PASSWORD 'blobber';
-GRANT ALL PRIVILEGES ON DATABASE blobber_meta TO blobber_user;
\ No newline at end of file
+GRANT ALL PRIVILEGES ON DATABASE blobber_meta TO blobber_user;
+
+-- The blobber applies the schema migrations as blobber_user, which must own
+-- the tables created by the former sql scripts to alter them.
+DO $$
+DECLARE t record;
+BEGIN
+    FOR t IN SELECT tablename FROM pg_tables WHERE schemaname = 'public' LOOP
+        EXECUTE format('ALTER TABLE %I OWNER TO blobber_user', t.tablename);
+    END LOOP;
+END $$;
@@ -1,3 +0,0 @@
-\connect blobber_meta;
-
-ALTER TABLE reference_objects ADD COLUMN on_cloud BOOLEAN DEFAULT FALSE;
\ No newline at end of file